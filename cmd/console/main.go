@@ -2,10 +2,17 @@ package main
 
 import (
 	"aurma_product/internal/di"
+	"aurma_product/internal/elastic"
+	"aurma_product/internal/models"
+	"context"
 	"fmt"
 	"github.com/spf13/cobra"
 	"log"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func main() {
@@ -20,7 +27,7 @@ func main() {
 
 	console.AddCommand(&cobra.Command{
 		Use:   "elastic-index-create",
-		Short: "create Elasticsearch index for products",
+		Short: "create a new Elasticsearch index version for products",
 		Run: func(cmd *cobra.Command, args []string) {
 			err := container.Elastic.ProductCreateIndex()
 			if err != nil {
@@ -39,9 +46,506 @@ func main() {
 		},
 	})
 
+	reindex := &cobra.Command{
+		Use:   "elastic-reindex",
+		Short: "rebuild products into a new index version and switch the alias",
+		Run: func(cmd *cobra.Command, args []string) {
+			deleteOld, _ := cmd.Flags().GetBool("delete-old")
+			index, err := container.ProductService.ReindexElastic(context.Background(), deleteOld)
+			if err != nil {
+				log.Printf("Error reindexing products: %v", err)
+				return
+			}
+			fmt.Printf("Alias %s now points to %s\n", elastic.IndexName, index)
+		},
+	}
+	reindex.Flags().Bool("delete-old", false, "delete the previous index version after switching")
+
+	console.AddCommand(reindex, &cobra.Command{
+		Use:   "elastic-index-versions",
+		Short: "list product index versions",
+		Run: func(cmd *cobra.Command, args []string) {
+			versions, err := container.Elastic.ProductIndexVersions(context.Background())
+			if err != nil {
+				log.Printf("Error listing index versions: %v", err)
+				return
+			}
+			for _, v := range versions {
+				active := ""
+				if v.Active {
+					active = "*"
+				}
+				fmt.Printf("%1s %-24s v%-4d docs: %d\n", active, v.Name, v.Version, v.DocsCount)
+			}
+		},
+	}, &cobra.Command{
+		Use:   "elastic-index-rollback [version]",
+		Short: "switch the product alias back to a previous index version",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			version := 0
+			if len(args) == 1 {
+				v, err := strconv.Atoi(args[0])
+				if err != nil {
+					log.Printf("Invalid version %q: %v", args[0], err)
+					return
+				}
+				version = v
+			}
+			index, err := container.Elastic.ProductRollbackIndex(context.Background(), version)
+			if err != nil {
+				log.Printf("Error rolling back index: %v", err)
+				return
+			}
+			fmt.Printf("Alias %s now points to %s\n", elastic.IndexName, index)
+		},
+	})
+
+	importFeed := &cobra.Command{
+		Use:   "supplier-import [supplier] [file]",
+		Short: "apply a supplier feed to product pharmacies",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			contentType, _ := cmd.Flags().GetString("type")
+			file, contentType, err := openFeed(args[1], contentType)
+			if err != nil {
+				log.Printf("Error opening feed: %v", err)
+				return
+			}
+			defer file.Close()
+
+			var result models.ImportResult
+			if full, _ := cmd.Flags().GetBool("full"); full {
+				hide, _ := cmd.Flags().GetBool("hide")
+				maxPercent, _ := cmd.Flags().GetFloat64("max-withdraw")
+				result, err = container.SupplierService.Sync(context.Background(), args[0], args[1], file, contentType, models.SyncOptions{
+					Hide:               hide,
+					MaxWithdrawPercent: maxPercent,
+				})
+			} else {
+				result, err = container.SupplierService.Import(context.Background(), args[0], args[1], file, contentType)
+			}
+			if err != nil {
+				log.Printf("Error importing feed: %v", err)
+			}
+			fmt.Printf("run #%d updated: %d, unchanged: %d, unknown: %d, failed: %d, withdrawn: %d, held: %d\n",
+				result.RunId, len(result.Updated), len(result.Unchanged), len(result.Unknown), len(result.Failed), len(result.Withdrawn),
+				len(result.Held))
+			for _, failure := range result.Failed {
+				fmt.Printf("  %s (store %s, line %d): %s\n", failure.SKU, failure.StoreId, failure.Line, failure.Error)
+			}
+			for _, hold := range result.Held {
+				fmt.Printf("  held %s (store %s): %s\n", hold.SKU, hold.StoreId, hold.Reason)
+			}
+		},
+	}
+	importFeed.Flags().String("type", "", "feed type: xml, json, csv or xlsx, taken from the file extension by default")
+	importFeed.Flags().Bool("full", false, "the feed is the full assortment: zero out supplier rows missing from it")
+	importFeed.Flags().Bool("hide", false, "with --full, hide missing rows instead of zeroing their stock")
+	importFeed.Flags().Float64("max-withdraw", 0, "with --full, abort if more than this percent of rows would be withdrawn (SYNC_MAX_WITHDRAW_PERCENT by default)")
+
+	dryRun := &cobra.Command{
+		Use:   "supplier-dry-run [supplier] [file]",
+		Short: "show what a supplier feed would change without writing anything",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			format, _ := cmd.Flags().GetString("format")
+			contentType, _ := cmd.Flags().GetString("type")
+			file, contentType, err := openFeed(args[1], contentType)
+			if err != nil {
+				log.Printf("Error opening feed: %v", err)
+				return
+			}
+			defer file.Close()
+
+			diff, err := container.SupplierService.DryRun(context.Background(), args[0], file, contentType)
+			if err != nil {
+				log.Printf("Error comparing feed: %v", err)
+				return
+			}
+
+			switch format {
+			case "json":
+				err = diff.WriteJSON(os.Stdout)
+			case "csv":
+				err = diff.WriteCSV(os.Stdout)
+			default:
+				err = fmt.Errorf("unknown format: %s", format)
+			}
+			if err != nil {
+				log.Printf("Error writing report: %v", err)
+			}
+		},
+	}
+	dryRun.Flags().String("format", "json", "report format: json or csv")
+	dryRun.Flags().String("type", "", "feed type: xml, json, csv or xlsx, taken from the file extension by default")
+
+	console.AddCommand(importFeed, dryRun, &cobra.Command{
+		Use:   "suppliers",
+		Short: "list registered suppliers",
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, name := range container.SupplierService.Suppliers() {
+				fmt.Println(name)
+			}
+		},
+	})
+
+	console.AddCommand(&cobra.Command{
+		Use:   "supplier-stores [supplier]",
+		Short: "list supplier stores mapped to pharmacies",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			stores, err := container.SupplierService.Stores(context.Background(), args[0])
+			if err != nil {
+				log.Printf("Error fetching stores: %v", err)
+				return
+			}
+			for _, store := range stores {
+				fmt.Printf("%q -> pharmacy %d\n", store.StoreId, store.PharmacyId)
+			}
+		},
+	}, &cobra.Command{
+		Use:   "supplier-store-set [supplier] [store_id] [pharmacy_id]",
+		Short: "map a supplier store to a pharmacy, use \"\" for price lists without a store column",
+		Args:  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			pharmacyId, err := strconv.Atoi(args[2])
+			if err != nil {
+				log.Printf("Invalid pharmacy id %q: %v", args[2], err)
+				return
+			}
+			if err := container.SupplierService.SetStore(context.Background(), args[0], args[1], pharmacyId); err != nil {
+				log.Printf("Error saving store: %v", err)
+				return
+			}
+			fmt.Printf("Store %q of %s mapped to pharmacy %d\n", args[1], args[0], pharmacyId)
+		},
+	}, &cobra.Command{
+		Use:   "supplier-store-delete [supplier] [store_id]",
+		Short: "remove a supplier store mapping",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := container.SupplierService.DeleteStore(context.Background(), args[0], args[1]); err != nil {
+				log.Printf("Error deleting store: %v", err)
+				return
+			}
+			fmt.Printf("Store %q of %s removed\n", args[1], args[0])
+		},
+	})
+
+	runs := &cobra.Command{
+		Use:   "supplier-runs [supplier]",
+		Short: "list supplier feed import runs, newest first",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			supplier := ""
+			if len(args) == 1 {
+				supplier = args[0]
+			}
+			limit, _ := cmd.Flags().GetInt("limit")
+			offset, _ := cmd.Flags().GetInt("offset")
+
+			runs, err := container.SupplierService.Runs(context.Background(), supplier, limit, offset)
+			if err != nil {
+				log.Printf("Error fetching import runs: %v", err)
+				return
+			}
+			for _, run := range runs {
+				printImportRun(run)
+			}
+		},
+	}
+	runs.Flags().Int("limit", 20, "number of runs to show")
+	runs.Flags().Int("offset", 0, "number of runs to skip")
+
+	showRun := &cobra.Command{
+		Use:   "supplier-run [id]",
+		Short: "show an import run with its price and stock changes",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				log.Printf("Invalid id %q: %v", args[0], err)
+				return
+			}
+			sku, _ := cmd.Flags().GetString("sku")
+
+			run, err := container.SupplierService.Run(context.Background(), id, sku)
+			if err != nil {
+				log.Printf("Error fetching import run: %v", err)
+				return
+			}
+			printImportRun(run)
+			for _, change := range run.Changes {
+				fmt.Printf("  %s pharmacy=%d product=%d price %d -> %d, count %d -> %d\n", change.SKU, change.PharmacyId,
+					change.ProductId, change.OldPrice, change.NewPrice, change.OldCount, change.NewCount)
+			}
+			for _, failure := range run.Failures {
+				fmt.Printf("  %s (store %s, line %d): %s\n", failure.SKU, failure.StoreId, failure.Line, failure.Error)
+			}
+		},
+	}
+	showRun.Flags().String("sku", "", "show only changes of this SKU")
+
+	rollback := &cobra.Command{
+		Use:   "supplier-rollback [id]",
+		Short: "restore prices and stock changed by an import run and reindex affected products",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				log.Printf("Invalid id %q: %v", args[0], err)
+				return
+			}
+			force, _ := cmd.Flags().GetBool("force")
+
+			result, err := container.SupplierService.Rollback(context.Background(), id, force)
+			if err != nil {
+				log.Printf("Error rolling back import run: %v", err)
+			}
+			fmt.Printf("run #%d restored: %d, unchanged: %d, skipped: %d\n",
+				result.RunId, len(result.Updated), len(result.Unchanged), len(result.Failed))
+			for _, failure := range result.Failed {
+				fmt.Printf("  %s (pharmacy %d): %s\n", failure.SKU, failure.PharmacyId, failure.Error)
+			}
+			if len(result.Failed) > 0 && !force {
+				if target, err := container.SupplierService.Run(context.Background(), id, ""); err == nil && !target.RolledBackBy.Valid {
+					fmt.Printf("run #%d is not marked rolled back, rerun with --force to restore skipped rows\n", id)
+				}
+			}
+		},
+	}
+	rollback.Flags().Bool("force", false, "also restore rows changed after the run")
+
+	console.AddCommand(runs, showRun, rollback)
+
+	holds := &cobra.Command{
+		Use:   "price-holds [supplier]",
+		Short: "list feed changes held by the price and stock guard",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			supplier := ""
+			if len(args) == 1 {
+				supplier = args[0]
+			}
+			status, _ := cmd.Flags().GetString("status")
+			limit, _ := cmd.Flags().GetInt("limit")
+			offset, _ := cmd.Flags().GetInt("offset")
+
+			holds, err := container.PriceHoldService.Holds(context.Background(), supplier, status, limit, offset)
+			if err != nil {
+				log.Printf("Error fetching price holds: %v", err)
+				return
+			}
+			for _, hold := range holds {
+				fmt.Printf("#%d %s %s pharmacy=%d price %d -> %d, count %d -> %d: %s [%s]\n", hold.Id, hold.Supplier, hold.SKU,
+					hold.PharmacyId, hold.OldPrice, hold.NewPrice, hold.OldCount, hold.NewCount, hold.Reason, hold.Status)
+			}
+		},
+	}
+	holds.Flags().String("status", "", "pending, approved or rejected, pending by default")
+	holds.Flags().Int("limit", 50, "number of holds to show")
+	holds.Flags().Int("offset", 0, "number of holds to skip")
+
+	console.AddCommand(holds, &cobra.Command{
+		Use:   "price-hold-approve [id]",
+		Short: "apply a held price and stock change",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				log.Printf("Invalid id %q: %v", args[0], err)
+				return
+			}
+			hold, err := container.PriceHoldService.Approve(context.Background(), id)
+			if err != nil {
+				log.Printf("Error approving price hold: %v", err)
+				return
+			}
+			fmt.Printf("SKU %s pharmacy %d: price %d, count %d\n", hold.SKU, hold.PharmacyId, hold.NewPrice, hold.NewCount)
+		},
+	}, &cobra.Command{
+		Use:   "price-hold-reject [id]",
+		Short: "discard a held price and stock change",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				log.Printf("Invalid id %q: %v", args[0], err)
+				return
+			}
+			hold, err := container.PriceHoldService.Reject(context.Background(), id)
+			if err != nil {
+				log.Printf("Error rejecting price hold: %v", err)
+				return
+			}
+			fmt.Printf("SKU %s pharmacy %d rejected\n", hold.SKU, hold.PharmacyId)
+		},
+	})
+
+	matches := &cobra.Command{
+		Use:   "supplier-matches [supplier]",
+		Short: "list supplier SKUs waiting to be matched with products",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			supplier := ""
+			if len(args) == 1 {
+				supplier = args[0]
+			}
+			status, _ := cmd.Flags().GetString("status")
+			limit, _ := cmd.Flags().GetInt("limit")
+			offset, _ := cmd.Flags().GetInt("offset")
+
+			matches, err := container.MatchService.Matches(context.Background(), supplier, status, limit, offset)
+			if err != nil {
+				log.Printf("Error fetching matches: %v", err)
+				return
+			}
+			for _, match := range matches {
+				fmt.Printf("#%d %s %s pharmacy=%d %q [%s]\n", match.Id, match.Supplier, match.Sku, match.PharmacyId, match.Title, match.Status)
+				for _, candidate := range match.Candidates {
+					fmt.Printf("    product=%d %.2f %s %q\n", candidate.ProductId, candidate.Confidence, candidate.Source, candidate.Title)
+				}
+			}
+		},
+	}
+	matches.Flags().String("status", "", "pending, accepted or rejected, pending by default")
+	matches.Flags().Int("limit", 50, "number of matches to show")
+	matches.Flags().Int("offset", 0, "number of matches to skip")
+
+	console.AddCommand(matches, &cobra.Command{
+		Use:   "supplier-match-accept [id] [product_id]",
+		Short: "link a queued supplier SKU to a product, the top candidate by default",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				log.Printf("Invalid id %q: %v", args[0], err)
+				return
+			}
+			productId := 0
+			if len(args) == 2 {
+				if productId, err = strconv.Atoi(args[1]); err != nil {
+					log.Printf("Invalid product id %q: %v", args[1], err)
+					return
+				}
+			}
+			match, err := container.MatchService.Accept(context.Background(), id, productId)
+			if err != nil {
+				log.Printf("Error accepting match: %v", err)
+				return
+			}
+			fmt.Printf("SKU %s linked to product %d\n", match.Sku, match.ProductId.Int64)
+		},
+	}, &cobra.Command{
+		Use:   "supplier-match-reject [id]",
+		Short: "reject a queued supplier SKU",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				log.Printf("Invalid id %q: %v", args[0], err)
+				return
+			}
+			match, err := container.MatchService.Reject(context.Background(), id)
+			if err != nil {
+				log.Printf("Error rejecting match: %v", err)
+				return
+			}
+			fmt.Printf("SKU %s rejected\n", match.Sku)
+		},
+	})
+
+	priceHistory := &cobra.Command{
+		Use:   "price-history [product_id]",
+		Short: "show price changes and daily price summary of a product",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			productId, err := strconv.Atoi(args[0])
+			if err != nil {
+				log.Printf("Invalid product id %q: %v", args[0], err)
+				return
+			}
+			pharmacyId, _ := cmd.Flags().GetInt("pharmacy")
+			days, _ := cmd.Flags().GetInt("days")
+			to := time.Now()
+			from := to.AddDate(0, 0, -days)
+			if value, _ := cmd.Flags().GetString("from"); value != "" {
+				if from, err = time.ParseInLocation(time.DateOnly, value, time.Local); err != nil {
+					log.Printf("Invalid from %q: %v", value, err)
+					return
+				}
+			}
+			if value, _ := cmd.Flags().GetString("to"); value != "" {
+				if to, err = time.ParseInLocation(time.DateOnly, value, time.Local); err != nil {
+					log.Printf("Invalid to %q: %v", value, err)
+					return
+				}
+			}
+
+			trend, err := container.PriceHistoryService.Trend(context.Background(), productId, pharmacyId, from, to)
+			if err != nil {
+				log.Printf("Error fetching price history: %v", err)
+				return
+			}
+			for _, point := range trend.Points {
+				fmt.Printf("%s pharmacy=%d price=%d count=%d\n", point.RecordedAt.Format(time.DateTime), point.PharmacyId, point.Price, point.Count)
+			}
+			for _, day := range trend.Days {
+				fmt.Printf("%s min=%d avg=%.2f max=%d\n", day.Day.Format(time.DateOnly), day.MinPrice, day.AvgPrice, day.MaxPrice)
+			}
+		},
+	}
+	priceHistory.Flags().Int("pharmacy", 0, "only this pharmacy, all pharmacies by default")
+	priceHistory.Flags().Int("days", 30, "number of days to show when --from is not set")
+	priceHistory.Flags().String("from", "", "start date, 2006-01-02")
+	priceHistory.Flags().String("to", "", "end date, exclusive, 2006-01-02")
+
+	console.AddCommand(priceHistory, &cobra.Command{
+		Use:   "price-history-prune",
+		Short: "delete price history older than the retention period",
+		Run: func(cmd *cobra.Command, args []string) {
+			deleted, err := container.PriceHistoryService.Prune(context.Background())
+			if err != nil {
+				log.Printf("Error pruning price history: %v", err)
+				return
+			}
+			fmt.Printf("Deleted %d price history points\n", deleted)
+		},
+	})
+
 	err = console.Execute()
 	if err != nil {
 		log.Printf("Error executing command: %v", err)
 		return
 	}
 }
+
+// printImportRun печатает строку с итогом запуска импорта.
+func printImportRun(run models.ImportRun) {
+	finishedAt := "-"
+	if run.FinishedAt.Valid {
+		finishedAt = run.FinishedAt.Time.Format(time.DateTime)
+	}
+	fmt.Printf("#%d %s %s %s [%s] %s .. %s updated: %d, unchanged: %d, unknown: %d, failed: %d, withdrawn: %d, held: %d sha256:%s\n",
+		run.Id, run.Supplier, run.Mode, run.Source, run.Status, run.StartedAt.Format(time.DateTime), finishedAt,
+		run.Updated, run.Unchanged, run.Unknown, run.Failed, run.Withdrawn, run.Held, run.FeedHash)
+	if run.Error.Valid {
+		fmt.Printf("  error: %s\n", run.Error.String)
+	}
+	if run.RolledBackBy.Valid {
+		fmt.Printf("  rolled back by run #%d\n", run.RolledBackBy.Int64)
+	}
+}
+
+// openFeed открывает файл фида и определяет его тип по расширению, если он не задан.
+func openFeed(path, contentType string) (*os.File, string, error) {
+	if contentType == "" {
+		contentType = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return file, contentType, nil
+}
@@ -1,21 +1,417 @@
 package server
 
 import (
+	"aurma_product/internal/elastic"
+	"aurma_product/internal/models/elasticModels"
+	"aurma_product/internal/services"
+	"aurma_product/internal/suppliers"
+	"bytes"
 	"context"
+	"errors"
 	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
 )
 
 func (s server) Search(ctx context.Context, req *pb.ProductSearchRequest) (*pb.ProductSearchResponse, error) {
-	productDetails, total, err := s.productService.Search(ctx, req.Title, int(req.Page), int(req.Limit), req.Sort.String(), int(req.MinPrice), int(req.MaxPrice))
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	// Без курсора from+size не может выйти за окно индекса, elastic такой запрос отклонит.
+	if !req.UseCursor && int64(page)*int64(limit) > elastic.MaxResultWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "page %d with limit %d exceeds %d results, use cursor pagination", page, limit, elastic.MaxResultWindow)
+	}
+
+	result, err := s.productService.Search(ctx, elasticModels.ProductSearchParams{
+		Text:        req.Title,
+		From:        (page - 1) * limit,
+		Size:        limit,
+		Sort:        req.Sort.String(),
+		MinPrice:    int(req.MinPrice),
+		MaxPrice:    int(req.MaxPrice),
+		ActiveOnly:  !req.IncludeInactive,
+		InStockOnly: req.InStockOnly,
+		Filter: elasticModels.ProductSearchFilter{
+			CompanyNames: req.CompanyNames,
+			Mnns:         req.Mnns,
+			IssueForms:   req.IssueForms,
+			Availability: availability(req.Availability),
+		},
+		UseCursor:        req.UseCursor,
+		Cursor:           req.Cursor,
+		PharmacyId:       int(req.PharmacyId),
+		CityId:           int(req.CityId),
+		MinShelfLifeDays: int(req.MinShelfLifeDays),
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidPagination) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return result.ToPbSearchResponse(), nil
+}
+
+func (s server) Suggest(ctx context.Context, req *pb.ProductSuggestRequest) (*pb.ProductSuggestResponse, error) {
+	suggestions, err := s.productService.Suggest(ctx, req.Text, int(req.Limit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := make([]*pb.ProductSuggestion, len(suggestions))
+	for i, suggestion := range suggestions {
+		result[i] = &pb.ProductSuggestion{
+			Id:    int32(suggestion.Id),
+			Title: suggestion.Title,
+			Type:  suggestion.Type,
+			Slug:  suggestion.Slug,
+		}
+	}
+	return &pb.ProductSuggestResponse{
+		Suggestions: result,
+	}, nil
+}
+
+func (s server) ScanBarcode(ctx context.Context, req *pb.ProductBarcodeRequest) (*pb.ProductBarcodeResponse, error) {
+	scan, err := s.productService.ScanBarcode(ctx, req.Code)
 	if err != nil {
-		return nil, err
+		switch {
+		case errors.Is(err, services.ErrInvalidBarcode):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, services.ErrProductNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return scan.ToPbBarcodeResponse(), nil
+}
+
+func (s server) Analogs(ctx context.Context, req *pb.ProductAnalogsRequest) (*pb.ProductAnalogsResponse, error) {
+	if req.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	productDetails, err := s.productService.Analogs(ctx, int(req.ProductId), req.SameIssueForm, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, services.ErrProductNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 	products := make([]*pb.Product, len(productDetails))
 	for i, pd := range productDetails {
 		products[i] = pd.ToPbProduct()
 	}
-	return &pb.ProductSearchResponse{
-		Products:   products,
-		TotalCount: int32(total),
+	return &pb.ProductAnalogsResponse{
+		Products: products,
+	}, nil
+}
+
+func (s server) Show(ctx context.Context, req *pb.ProductShowRequest) (*pb.ProductShowResponse, error) {
+	key := req.Slug
+	if req.Id > 0 {
+		key = strconv.Itoa(int(req.Id))
+	}
+	if key == "" {
+		return nil, status.Error(codes.InvalidArgument, "id or slug is required")
+	}
+
+	productDetail, err := s.productService.Show(ctx, key)
+	if err != nil {
+		if errors.Is(err, services.ErrProductNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.ProductShowResponse{
+		Product: productDetail.ToPbProduct(),
+	}, nil
+}
+
+func (s server) Offers(ctx context.Context, req *pb.ProductOffersRequest) (*pb.ProductOffersResponse, error) {
+	if req.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	productPharmacies, err := s.productService.Offers(ctx, int(req.ProductId), req.Sort.String(), req.InStockOnly, int(req.MinShelfLifeDays))
+	if err != nil {
+		if errors.Is(err, services.ErrProductNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	offers := make([]*pb.ProductOffer, len(productPharmacies))
+	for i, pp := range productPharmacies {
+		offers[i] = pp.ToPbOffer(s.cfg.ShortDatedDays)
+	}
+	return &pb.ProductOffersResponse{
+		Offers: offers,
+	}, nil
+}
+
+// availability переводит enum наличия из запроса в значение фильтра поиска.
+func availability(a pb.ProductAvailability) string {
+	switch a {
+	case pb.ProductAvailability_IN_STOCK:
+		return elasticModels.AvailabilityInStock
+	case pb.ProductAvailability_OUT_OF_STOCK:
+		return elasticModels.AvailabilityOutOfStock
+	default:
+		return ""
+	}
+}
+
+const defaultSupplierMatchesLimit = 50
+
+func (s server) SupplierMatches(ctx context.Context, req *pb.SupplierMatchesRequest) (*pb.SupplierMatchesResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSupplierMatchesLimit
+	}
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+
+	matches, err := s.matchService.Matches(ctx, req.Supplier, req.Status, limit, (page-1)*limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := make([]*pb.SupplierMatch, len(matches))
+	for i := range matches {
+		result[i] = matches[i].ToPb()
+	}
+	return &pb.SupplierMatchesResponse{
+		Matches: result,
+	}, nil
+}
+
+func (s server) AcceptSupplierMatch(ctx context.Context, req *pb.SupplierMatchAcceptRequest) (*pb.SupplierMatchResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	match, err := s.matchService.Accept(ctx, int(req.Id), int(req.ProductId))
+	if err != nil {
+		return nil, matchStatus(err)
+	}
+	return &pb.SupplierMatchResponse{
+		Match: match.ToPb(),
+	}, nil
+}
+
+func (s server) RejectSupplierMatch(ctx context.Context, req *pb.SupplierMatchRejectRequest) (*pb.SupplierMatchResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	match, err := s.matchService.Reject(ctx, int(req.Id))
+	if err != nil {
+		return nil, matchStatus(err)
+	}
+	return &pb.SupplierMatchResponse{
+		Match: match.ToPb(),
+	}, nil
+}
+
+// matchStatus переводит ошибки очереди сопоставления в коды gRPC.
+func matchStatus(err error) error {
+	switch {
+	case errors.Is(err, services.ErrMatchNotFound), errors.Is(err, services.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrMatchResolved), errors.Is(err, services.ErrMatchNoCandidate):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+const defaultImportRunsLimit = 20
+
+func (s server) SupplierImportRuns(ctx context.Context, req *pb.SupplierImportRunsRequest) (*pb.SupplierImportRunsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultImportRunsLimit
+	}
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+
+	runs, err := s.supplierService.Runs(ctx, req.Supplier, limit, (page-1)*limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := make([]*pb.SupplierImportRun, len(runs))
+	for i := range runs {
+		result[i] = runs[i].ToPb()
+	}
+	return &pb.SupplierImportRunsResponse{
+		Runs: result,
+	}, nil
+}
+
+func (s server) SupplierImportRun(ctx context.Context, req *pb.SupplierImportRunRequest) (*pb.SupplierImportRunResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	run, err := s.supplierService.Run(ctx, int(req.Id), req.Sku)
+	if err != nil {
+		if errors.Is(err, services.ErrImportRunNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SupplierImportRunResponse{
+		Run: run.ToPb(),
+	}, nil
+}
+
+func (s server) RollbackSupplierImport(ctx context.Context, req *pb.SupplierImportRollbackRequest) (*pb.SupplierImportRollbackResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	result, err := s.supplierService.Rollback(ctx, int(req.Id), req.Force)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrImportRunNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, services.ErrImportRunRolledBack), errors.Is(err, services.ErrImportRunRunning):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SupplierImportRollbackResponse{
+		RunId:     int32(result.RunId),
+		Restored:  int32(len(result.Updated)),
+		Unchanged: int32(len(result.Unchanged)),
+		Skipped:   int32(len(result.Failed)),
+	}, nil
+}
+
+func (s server) SupplierDryRun(ctx context.Context, req *pb.SupplierDryRunRequest) (*pb.SupplierDryRunResponse, error) {
+	if req.Supplier == "" {
+		return nil, status.Error(codes.InvalidArgument, "supplier is required")
+	}
+	if len(req.Feed) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "feed is required")
+	}
+	diff, err := s.supplierService.DryRun(ctx, req.Supplier, bytes.NewReader(req.Feed), req.ContentType)
+	if err != nil {
+		if errors.Is(err, suppliers.ErrUnknownSupplier) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return diff.ToPb(), nil
+}
+
+const defaultPriceHoldsLimit = 50
+
+func (s server) PriceHolds(ctx context.Context, req *pb.PriceHoldsRequest) (*pb.PriceHoldsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPriceHoldsLimit
+	}
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+
+	holds, err := s.priceHoldService.Holds(ctx, req.Supplier, req.Status, limit, (page-1)*limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	result := make([]*pb.PriceHold, len(holds))
+	for i := range holds {
+		result[i] = holds[i].ToPb()
+	}
+	return &pb.PriceHoldsResponse{
+		Holds: result,
+	}, nil
+}
+
+func (s server) ApprovePriceHold(ctx context.Context, req *pb.PriceHoldRequest) (*pb.PriceHoldResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	hold, err := s.priceHoldService.Approve(ctx, int(req.Id))
+	if err != nil {
+		return nil, holdStatus(err)
+	}
+	return &pb.PriceHoldResponse{
+		Hold: hold.ToPb(),
 	}, nil
 }
+
+func (s server) RejectPriceHold(ctx context.Context, req *pb.PriceHoldRequest) (*pb.PriceHoldResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	hold, err := s.priceHoldService.Reject(ctx, int(req.Id))
+	if err != nil {
+		return nil, holdStatus(err)
+	}
+	return &pb.PriceHoldResponse{
+		Hold: hold.ToPb(),
+	}, nil
+}
+
+// holdStatus переводит ошибки задержанных изменений в коды gRPC.
+func holdStatus(err error) error {
+	switch {
+	case errors.Is(err, services.ErrHoldNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrHoldResolved), errors.Is(err, services.ErrHoldStale):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// defaultPriceHistoryDays период истории цен, если границы не заданы.
+const defaultPriceHistoryDays = 30
+
+func (s server) ProductPriceHistory(ctx context.Context, req *pb.ProductPriceHistoryRequest) (*pb.ProductPriceHistoryResponse, error) {
+	if req.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	to, err := parseHistoryTime(req.To, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to: "+err.Error())
+	}
+	from, err := parseHistoryTime(req.From, to.AddDate(0, 0, -defaultPriceHistoryDays))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid from: "+err.Error())
+	}
+	if !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	trend, err := s.priceHistoryService.Trend(ctx, int(req.ProductId), int(req.PharmacyId), from, to)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return trend.ToPb(), nil
+}
+
+// parseHistoryTime разбирает дату в формате 2006-01-02 или RFC3339, пустая строка дает fallback.
+func parseHistoryTime(value string, fallback time.Time) (time.Time, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -10,13 +10,21 @@ import (
 
 type server struct {
 	pb.UnimplementedProductServiceServer
-	productService services.ProductService
-	cfg            *config.Config
+	productService      services.ProductService
+	matchService        services.MatchService
+	supplierService     services.SupplierService
+	priceHoldService    services.PriceHoldService
+	priceHistoryService services.PriceHistoryService
+	cfg                 *config.Config
 }
 
 func Register(gRPC *grpc.Server, container *di.Container) {
 	pb.RegisterProductServiceServer(gRPC, &server{
-		productService: container.ProductService,
-		cfg:            container.Config,
+		productService:      container.ProductService,
+		matchService:        container.MatchService,
+		supplierService:     container.SupplierService,
+		priceHoldService:    container.PriceHoldService,
+		priceHistoryService: container.PriceHistoryService,
+		cfg:                 container.Config,
 	})
 }
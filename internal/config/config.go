@@ -23,6 +23,32 @@ type Config struct {
 
 	ElasticHost string `env:"ELASTIC_HOST" required:"true"`
 	ElasticPort string `env:"ELASTIC_PORT" required:"true"`
+
+	ElasticBulkWorkers    int    `env:"ELASTIC_BULK_WORKERS" env-default:"2"`
+	ElasticBulkFlushDocs  int    `env:"ELASTIC_BULK_FLUSH_DOCS" env-default:"1000"`
+	ElasticBulkFlushBytes int    `env:"ELASTIC_BULK_FLUSH_BYTES" env-default:"5242880"`
+	ElasticBulkMaxRetries int    `env:"ELASTIC_BULK_MAX_RETRIES" env-default:"3"`
+	ElasticRefresh        string `env:"ELASTIC_REFRESH" env-default:"false"`
+
+	ImportWorkers   int `env:"IMPORT_WORKERS" env-default:"4"`
+	ImportBatchSize int `env:"IMPORT_BATCH_SIZE" env-default:"500"`
+	// SyncMaxWithdrawPercent порог полной сверки: доля строк поставщика, которые можно снять с продажи.
+	SyncMaxWithdrawPercent float64 `env:"SYNC_MAX_WITHDRAW_PERCENT" env-default:"20"`
+
+	// Правила проверки изменений из фидов, 0 отключает правило.
+	GuardMinPrice              int     `env:"GUARD_MIN_PRICE" env-default:"1"`
+	GuardMaxPriceChangePercent float64 `env:"GUARD_MAX_PRICE_CHANGE_PERCENT" env-default:"50"`
+	GuardMaxStockFactor        float64 `env:"GUARD_MAX_STOCK_FACTOR" env-default:"10"`
+	GuardMinStockSpike         int     `env:"GUARD_MIN_STOCK_SPIKE" env-default:"100"`
+
+	// PriceHistoryRetentionDays сколько дней хранится история цен и остатков.
+	PriceHistoryRetentionDays int `env:"PRICE_HISTORY_RETENTION_DAYS" env-default:"365"`
+
+	// ShortDatedDays срок годности, при котором предложение помечается как короткое.
+	ShortDatedDays int `env:"SHORT_DATED_DAYS" env-default:"90"`
+
+	// SuppliersFile JSON с разметкой фидов дополнительных поставщиков.
+	SuppliersFile string `env:"SUPPLIERS_FILE" env-default:""`
 }
 
 func Load() *Config {
@@ -6,16 +6,21 @@ import (
 	"aurma_product/internal/elastic"
 	"aurma_product/internal/repositories"
 	"aurma_product/internal/services"
+	"aurma_product/internal/suppliers"
 	"github.com/antibomberman/dblayer"
 	"github.com/jmoiron/sqlx"
 )
 
 type Container struct {
-	Config          *config.Config
-	DB              *sqlx.DB
-	Elastic         *elastic.Elastic
-	ProductService  services.ProductService
-	SadykhanService services.SadykhanService
+	Config              *config.Config
+	DB                  *sqlx.DB
+	Elastic             *elastic.Elastic
+	ProductService      services.ProductService
+	SadykhanService     services.SadykhanService
+	SupplierService     services.SupplierService
+	MatchService        services.MatchService
+	PriceHoldService    services.PriceHoldService
+	PriceHistoryService services.PriceHistoryService
 }
 
 func NewContainer() (*Container, error) {
@@ -31,18 +36,49 @@ func NewContainer() (*Container, error) {
 		return nil, err
 	}
 	// Initialize Elasticsearch
-	container.Elastic, err = elastic.New()
+	container.Elastic, err = elastic.New(container.Config)
 	if err != nil {
 		return nil, err
 	}
 
 	// Initialize repositories
 	productRepo := repositories.NewProductRepository(container.DB)
+	supplierRepo := repositories.NewSupplierRepository(container.DB)
+	matchRepo := repositories.NewMatchRepository(container.DB)
+	importRunRepo := repositories.NewImportRunRepository(container.DB)
+	priceHoldRepo := repositories.NewPriceHoldRepository(container.DB)
+	priceHistoryRepo := repositories.NewPriceHistoryRepository(container.DB)
 	dblayer := dblayer.NewDBLayer(container.DB)
 
+	// Initialize suppliers
+	registry := suppliers.NewRegistry(suppliers.NewSadykhan())
+	if container.Config.SuppliersFile != "" {
+		feedSuppliers, err := suppliers.LoadFile(container.Config.SuppliersFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, supplier := range feedSuppliers {
+			registry.Register(supplier)
+		}
+	}
+
 	// Initialize services
-	container.ProductService = services.NewProductService(dblayer, productRepo, container.Elastic)
-	container.SadykhanService = services.NewSadykhanService(dblayer, productRepo)
+	container.ProductService = services.NewProductService(dblayer, productRepo, priceHistoryRepo, container.Elastic)
+	container.MatchService = services.NewMatchService(matchRepo, productRepo, container.Elastic)
+	container.SupplierService = services.NewSupplierService(dblayer, productRepo, supplierRepo, importRunRepo, priceHoldRepo, priceHistoryRepo, container.ProductService, container.MatchService, registry, services.ImportConfig{
+		Workers:            container.Config.ImportWorkers,
+		BatchSize:          container.Config.ImportBatchSize,
+		MaxWithdrawPercent: container.Config.SyncMaxWithdrawPercent,
+		Guard: services.GuardConfig{
+			MinPrice:              container.Config.GuardMinPrice,
+			MaxPriceChangePercent: container.Config.GuardMaxPriceChangePercent,
+			MaxStockFactor:        container.Config.GuardMaxStockFactor,
+			MinStockSpike:         container.Config.GuardMinStockSpike,
+		},
+	})
+	container.PriceHoldService = services.NewPriceHoldService(priceHoldRepo, priceHistoryRepo, importRunRepo, container.ProductService)
+	container.PriceHistoryService = services.NewPriceHistoryService(priceHistoryRepo, container.Config.PriceHistoryRetentionDays)
+	container.SadykhanService = services.NewSadykhanService(container.SupplierService)
 
 	return container, nil
 }
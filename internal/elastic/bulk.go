@@ -0,0 +1,220 @@
+package elastic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+const (
+	bulkRetryBackoff = 500 * time.Millisecond
+	// bulkMaxErrors ограничивает число ошибок, сохраняемых в BulkStats.
+	bulkMaxErrors = 100
+)
+
+// BulkConfig настройки пакетной индексации.
+type BulkConfig struct {
+	Workers    int
+	FlushDocs  int
+	FlushBytes int
+	MaxRetries int
+	// Refresh политика обновления индекса: "false", "true" или "wait_for".
+	Refresh string
+}
+
+// BulkStats итог пакетной индексации.
+type BulkStats struct {
+	Indexed int
+	Failed  int
+	Errors  []BulkItemError
+}
+
+// BulkItemError ошибка индексации одного документа.
+type BulkItemError struct {
+	Id     string
+	Status int
+	Reason string
+}
+
+func (s *BulkStats) merge(other BulkStats) {
+	s.Indexed += other.Indexed
+	s.Failed += other.Failed
+	for _, e := range other.Errors {
+		if len(s.Errors) >= bulkMaxErrors {
+			break
+		}
+		s.Errors = append(s.Errors, e)
+	}
+}
+
+// bulkItem строка действия и тело документа в формате NDJSON.
+type bulkItem struct {
+	id   string
+	body []byte
+}
+
+// bulkIndex индексирует документы в index пачками, ограниченными по количеству
+// и размеру, в несколько воркеров. Ответ разбирается по каждому документу:
+// 429 и 5xx повторяются с экспоненциальной задержкой, остальные ошибки
+// попадают в BulkStats.
+func (es *Elastic) bulkIndex(ctx context.Context, index string, items []bulkItem) BulkStats {
+	cfg := es.bulk
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.FlushDocs <= 0 {
+		cfg.FlushDocs = 1000
+	}
+	if cfg.FlushBytes <= 0 {
+		cfg.FlushBytes = 5 << 20
+	}
+	if cfg.Refresh == "" {
+		cfg.Refresh = "false"
+	}
+
+	batches := make(chan []bulkItem)
+	results := make(chan BulkStats)
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				results <- es.bulkSend(ctx, index, batch, cfg)
+			}
+		}()
+	}
+	go func() {
+		defer close(batches)
+		var batch []bulkItem
+		size := 0
+		for _, item := range items {
+			if len(batch) > 0 && (len(batch) >= cfg.FlushDocs || size+len(item.body) > cfg.FlushBytes) {
+				batches <- batch
+				batch, size = nil, 0
+			}
+			batch = append(batch, item)
+			size += len(item.body)
+		}
+		if len(batch) > 0 {
+			batches <- batch
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var stats BulkStats
+	for result := range results {
+		stats.merge(result)
+	}
+	return stats
+}
+
+// bulkSend отправляет одну пачку, повторяя документы с временными ошибками.
+func (es *Elastic) bulkSend(ctx context.Context, index string, batch []bulkItem, cfg BulkConfig) BulkStats {
+	var stats BulkStats
+	pending := batch
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				stats.fail(pending, 0, ctx.Err().Error())
+				return stats
+			case <-time.After(bulkRetryBackoff * time.Duration(1<<(attempt-1))):
+			}
+		}
+		lastAttempt := attempt >= cfg.MaxRetries
+
+		retry, err := es.bulkRequest(ctx, index, pending, cfg.Refresh, &stats, lastAttempt)
+		if err != nil {
+			if lastAttempt {
+				stats.fail(pending, 0, err.Error())
+				return stats
+			}
+			log.Printf("Warning: bulk request failed, retrying %d documents: %v", len(pending), err)
+			continue
+		}
+		pending = retry
+	}
+	return stats
+}
+
+// bulkRequest выполняет один запрос _bulk. Возвращает документы, которые нужно
+// повторить, либо ошибку, если повторить нужно всю пачку.
+func (es *Elastic) bulkRequest(ctx context.Context, index string, items []bulkItem, refresh string, stats *BulkStats, lastAttempt bool) ([]bulkItem, error) {
+	var buf bytes.Buffer
+	for _, item := range items {
+		buf.Write(item.body)
+	}
+
+	req := esapi.BulkRequest{
+		Index:   index,
+		Body:    &buf,
+		Refresh: refresh,
+	}
+	res, err := req.Do(ctx, es.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform bulk request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("bulk request error: %s", res.Status())
+	}
+	if res.IsError() {
+		stats.fail(items, res.StatusCode, res.String())
+		return nil, nil
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Id     string `json:"_id"`
+			Status int    `json:"status"`
+			Error  struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse bulk response: %w", err)
+	}
+	if len(result.Items) != len(items) {
+		return nil, fmt.Errorf("bulk response has %d items, expected %d", len(result.Items), len(items))
+	}
+
+	var retry []bulkItem
+	for i, resultItem := range result.Items {
+		for _, r := range resultItem {
+			switch {
+			case r.Status >= 200 && r.Status < 300:
+				stats.Indexed++
+			case (r.Status == http.StatusTooManyRequests || r.Status >= http.StatusInternalServerError) && !lastAttempt:
+				retry = append(retry, items[i])
+			default:
+				stats.fail(items[i:i+1], r.Status, r.Error.Type+": "+r.Error.Reason)
+			}
+		}
+	}
+	return retry, nil
+}
+
+func (s *BulkStats) fail(items []bulkItem, status int, reason string) {
+	s.Failed += len(items)
+	for _, item := range items {
+		if len(s.Errors) >= bulkMaxErrors {
+			return
+		}
+		s.Errors = append(s.Errors, BulkItemError{Id: item.id, Status: status, Reason: reason})
+	}
+}
@@ -1,6 +1,7 @@
 package elastic
 
 import (
+	"aurma_product/internal/config"
 	"github.com/elastic/go-elasticsearch/v7"
 	"log"
 	"net/http"
@@ -10,12 +11,13 @@ import (
 // Elastic представляет клиент Elasticsearch.
 type Elastic struct {
 	client *elasticsearch.Client
+	bulk   BulkConfig
 }
 
 // New создает и возвращает новый экземпляр Elastic.
-func New() (*Elastic, error) {
+func New(cfg *config.Config) (*Elastic, error) {
 	es, err := elasticsearch.NewClient(elasticsearch.Config{
-		Addresses: []string{"http://localhost:9200"},
+		Addresses: []string{"http://" + cfg.ElasticHost + ":" + cfg.ElasticPort},
 		Transport: &http.Transport{
 			MaxIdleConnsPerHost:   10,
 			ResponseHeaderTimeout: time.Second * 10,
@@ -24,7 +26,13 @@ func New() (*Elastic, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Elastic{client: es}, nil
+	return &Elastic{client: es, bulk: BulkConfig{
+		Workers:    cfg.ElasticBulkWorkers,
+		FlushDocs:  cfg.ElasticBulkFlushDocs,
+		FlushBytes: cfg.ElasticBulkFlushBytes,
+		MaxRetries: cfg.ElasticBulkMaxRetries,
+		Refresh:    cfg.ElasticRefresh,
+	}}, nil
 }
 
 // Check проверяет соединение с Elasticsearch.
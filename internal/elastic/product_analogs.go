@@ -0,0 +1,80 @@
+package elastic
+
+import (
+	"aurma_product/internal/models/elasticModels"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ProductAnalogs ищет активные продукты с тем же набором МНН, а при заданном
+// issueForm еще и с той же формой выпуска. Продукты в наличии идут первыми,
+// внутри группы — по возрастанию цены.
+func (es *Elastic) ProductAnalogs(ctx context.Context, productId int, mnn, issueForm string, size int) ([]elasticModels.Product, error) {
+	filters := []map[string]interface{}{
+		{"term": map[string]interface{}{"mnn.raw": mnn}},
+		{"term": map[string]interface{}{"is_active": true}},
+	}
+	if issueForm != "" {
+		filters = append(filters, map[string]interface{}{
+			"term": map[string]interface{}{"issue_form.raw": issueForm},
+		})
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": filters,
+				"must_not": map[string]interface{}{
+					"ids": map[string]interface{}{"values": []string{fmt.Sprint(productId)}},
+				},
+			},
+		},
+		"sort": []map[string]interface{}{
+			{"_script": map[string]interface{}{
+				"type":   "number",
+				"order":  "asc",
+				"script": map[string]interface{}{"source": "doc['count'].value > 0 ? 0 : 1"},
+			}},
+			{"price": map[string]interface{}{"order": "asc"}},
+		},
+		"size": size,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return nil, fmt.Errorf("failed to encode query: %w", err)
+	}
+
+	res, err := es.client.Search(
+		es.client.Search.WithContext(ctx),
+		es.client.Search.WithIndex(IndexName),
+		es.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform search: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("search error: %s", res.String())
+	}
+
+	var result struct {
+		Hits struct {
+			Hits []struct {
+				Source elasticModels.Product `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	products := make([]elasticModels.Product, len(result.Hits.Hits))
+	for i, hit := range result.Hits.Hits {
+		products[i] = hit.Source
+	}
+	return products, nil
+}
@@ -0,0 +1,65 @@
+package elastic
+
+import (
+	"aurma_product/internal/models/elasticModels"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ProductByBarcode ищет продукт по точному совпадению штрихкода.
+// Поле barcode хранит штрихкоды продукта через запятую, поэтому код сравнивается
+// и со всем значением barcode.raw, и с отдельными токенами поля barcode.
+func (es *Elastic) ProductByBarcode(ctx context.Context, codes []string) (elasticModels.Product, bool, error) {
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []map[string]interface{}{
+					{"terms": map[string]interface{}{"barcode.raw": codes}},
+					{"terms": map[string]interface{}{"barcode": codes}},
+				},
+				"minimum_should_match": 1,
+			},
+		},
+		"sort": []map[string]interface{}{
+			{"is_active": map[string]interface{}{"order": "desc"}},
+			{"count": map[string]interface{}{"order": "desc"}},
+		},
+		"size": 1,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return elasticModels.Product{}, false, fmt.Errorf("failed to encode query: %w", err)
+	}
+
+	res, err := es.client.Search(
+		es.client.Search.WithContext(ctx),
+		es.client.Search.WithIndex(IndexName),
+		es.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return elasticModels.Product{}, false, fmt.Errorf("failed to perform search: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return elasticModels.Product{}, false, fmt.Errorf("search error: %s", res.String())
+	}
+
+	var result struct {
+		Hits struct {
+			Hits []struct {
+				Source elasticModels.Product `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return elasticModels.Product{}, false, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(result.Hits.Hits) == 0 {
+		return elasticModels.Product{}, false, nil
+	}
+	return result.Hits.Hits[0].Source, true, nil
+}
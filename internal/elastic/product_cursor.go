@@ -0,0 +1,121 @@
+package elastic
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// pitKeepAlive время жизни point in time между запросами страниц.
+const pitKeepAlive = "1m"
+
+// MaxResultWindow значение index.max_result_window по умолчанию: глубже from+size
+// страницы отдаются только курсором.
+const MaxResultWindow = 10000
+
+var (
+	ErrInvalidCursor = errors.New("invalid search cursor")
+	// ErrCursorExpired возвращается, когда point in time курсора уже закрыт или истек.
+	ErrCursorExpired        = errors.New("search cursor expired")
+	ErrResultWindowExceeded = errors.New("result window exceeded, use cursor pagination")
+)
+
+// searchCursor содержимое непрозрачного токена следующей страницы.
+// QueryHash привязывает курсор к запросу и сортировке, которые его выдали.
+type searchCursor struct {
+	PitId       string            `json:"p"`
+	SearchAfter []json.RawMessage `json:"a"`
+	QueryHash   string            `json:"q"`
+}
+
+// queryHash возвращает хеш условий поиска и сортировки, от которых зависит search_after.
+func queryHash(query map[string]interface{}) (string, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"query":       query["query"],
+		"post_filter": query["post_filter"],
+		"sort":        query["sort"],
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash query: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func encodeCursor(c searchCursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(token string) (searchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return searchCursor{}, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	var c searchCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.PitId == "" {
+		return searchCursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// openPointInTime открывает point in time на индексе продуктов.
+func (es *Elastic) openPointInTime(ctx context.Context) (string, error) {
+	res, err := es.client.OpenPointInTime(
+		[]string{IndexName},
+		pitKeepAlive,
+		es.client.OpenPointInTime.WithContext(ctx),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to open point in time: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return "", fmt.Errorf("open point in time error: %s", res.String())
+	}
+
+	var result struct {
+		Id string `json:"id"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	return result.Id, nil
+}
+
+// closePointInTime закрывает point in time, когда курсор дошел до последней страницы.
+func (es *Elastic) closePointInTime(ctx context.Context, pitId string) error {
+	body, err := json.Marshal(map[string]string{"id": pitId})
+	if err != nil {
+		return fmt.Errorf("failed to encode point in time: %w", err)
+	}
+	res, err := es.client.ClosePointInTime(
+		es.client.ClosePointInTime.WithBody(bytes.NewReader(body)),
+		es.client.ClosePointInTime.WithContext(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to close point in time: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("close point in time error: %s", res.String())
+	}
+	return nil
+}
+
+// pitExpired сообщает, что поиск упал из-за закрытого или истекшего point in time.
+func pitExpired(statusCode int, body string) bool {
+	return statusCode == http.StatusNotFound || strings.Contains(body, "search_context_missing_exception")
+}
@@ -7,13 +7,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"log"
 	"strings"
 )
 
+// IndexName алиас, через который читается и пишется индекс продуктов.
+// Сами индексы версионируются как product_list_v{N}, см. product_index.go.
 const IndexName = "product_list"
 
-// ProductCreateIndex создает индекс для продуктов в Elasticsearch.
-func (es *Elastic) ProductCreateIndex() error {
+// createProductIndex создает индекс для продуктов в Elasticsearch с указанным именем.
+func (es *Elastic) createProductIndex(name string) error {
 	settings := map[string]interface{}{
 		"settings": map[string]interface{}{
 			"number_of_shards":   1,
@@ -106,10 +109,30 @@ func (es *Elastic) ProductCreateIndex() error {
 						"raw": map[string]interface{}{
 							"type": "keyword",
 						},
+						"ngram": map[string]interface{}{
+							"type":     "text",
+							"analyzer": "ngram_analyzer",
+						},
 					},
 				},
 				"issue_form": map[string]interface{}{
 					"type": "text",
+					"fields": map[string]interface{}{
+						"raw": map[string]interface{}{
+							"type": "keyword",
+						},
+					},
+				},
+				"offers": map[string]interface{}{
+					"type": "nested",
+					"properties": map[string]interface{}{
+						"pharmacy_id":     map[string]interface{}{"type": "integer"},
+						"city_id":         map[string]interface{}{"type": "integer"},
+						"price":           map[string]interface{}{"type": "integer"},
+						"count":           map[string]interface{}{"type": "integer"},
+						"updated_at":      map[string]interface{}{"type": "date"},
+						"expiration_date": map[string]interface{}{"type": "date"},
+					},
 				},
 			},
 		},
@@ -121,7 +144,7 @@ func (es *Elastic) ProductCreateIndex() error {
 	}
 
 	res, err := es.client.Indices.Create(
-		IndexName,
+		name,
 		es.client.Indices.Create.WithBody(&buf),
 		es.client.Indices.Create.WithContext(context.Background()),
 	)
@@ -142,127 +165,221 @@ func (es *Elastic) ProductCreateIndex() error {
 }
 
 // ProductAddDocument добавляет продукты в Elasticsearch.
-func (es *Elastic) ProductAddDocument(products []elasticModels.Product) error {
-	var buf bytes.Buffer
+func (es *Elastic) ProductAddDocument(products []elasticModels.Product) (BulkStats, error) {
+	return es.ProductAddDocumentToIndex(context.Background(), IndexName, products)
+}
+
+// ProductAddDocumentToIndex добавляет продукты в указанный индекс или алиас
+// и возвращает количество проиндексированных и упавших документов.
+func (es *Elastic) ProductAddDocumentToIndex(ctx context.Context, index string, products []elasticModels.Product) (BulkStats, error) {
+	items := make([]bulkItem, 0, len(products))
 	for _, product := range products {
-		meta := []byte(fmt.Sprintf(`{"index":{"_index":"%s","_id":"%d"}}%s`, IndexName, product.Id, "\n"))
+		meta := []byte(fmt.Sprintf(`{"index":{"_index":"%s","_id":"%d"}}%s`, index, product.Id, "\n"))
 		data, err := json.Marshal(product)
 		if err != nil {
-			return fmt.Errorf("failed to marshal product: %w", err)
+			return BulkStats{}, fmt.Errorf("failed to marshal product: %w", err)
 		}
-		buf.Grow(len(meta) + len(data) + 1)
-		buf.Write(meta)
-		buf.Write(data)
-		buf.WriteByte('\n')
+		body := make([]byte, 0, len(meta)+len(data)+1)
+		body = append(body, meta...)
+		body = append(body, data...)
+		body = append(body, '\n')
+		items = append(items, bulkItem{id: fmt.Sprint(product.Id), body: body})
 	}
 
-	req := esapi.BulkRequest{
-		Index:   IndexName,
-		Body:    &buf,
-		Refresh: "true",
-	}
-
-	res, err := req.Do(context.Background(), es.client)
-	if err != nil {
-		return fmt.Errorf("failed to perform bulk request: %w", err)
-	}
-	defer res.Body.Close()
-
-	if res.IsError() {
-		var e map[string]interface{}
-		if err := json.NewDecoder(res.Body).Decode(&e); err != nil {
-			return fmt.Errorf("failed to parse error response: %w", err)
-		}
-		return fmt.Errorf("[%s] %s: %s", res.Status(), e["error"].(map[string]interface{})["type"], e["error"].(map[string]interface{})["reason"])
-	}
-
-	return nil
+	return es.bulkIndex(ctx, index, items), nil
 }
 
-// ProductSearch выполняет поиск продуктов по тексту.
-func (es *Elastic) ProductSearch(ctx context.Context, text string, from, size int, sort string, minPrice, maxPrice int) ([]elasticModels.Product, int, error) {
-	boolQuery := map[string]interface{}{
-		"must": map[string]interface{}{
+// ProductSearch выполняет поиск продуктов по тексту с фильтрами и фасетами.
+func (es *Elastic) ProductSearch(ctx context.Context, params elasticModels.ProductSearchParams) (elasticModels.ProductSearchResult, error) {
+	boolQuery := map[string]interface{}{}
+	if strings.TrimSpace(params.Text) != "" {
+		boolQuery["must"] = map[string]interface{}{
 			"multi_match": map[string]interface{}{
-				"query":                text,
+				"query":                params.Text,
 				"type":                 "best_fields",
 				"fields":               []string{"name", "company_name", "barcode", "mnn", "issue_form"},
 				"minimum_should_match": "90%",
 				"fuzziness":            "AUTO:0,2",
 			},
-		},
+		}
 	}
 
-	if minPrice >= 0 || maxPrice > 0 {
-		fmt.Println("minPrice >= 0 || maxPrice > 0")
-		priceRange := map[string]interface{}{}
-		if minPrice > 0 {
-			fmt.Println("minPrice > 0")
-			priceRange["gte"] = minPrice
+	// При выборе аптеки или города цена и остаток берутся из вложенных предложений
+	// этой аптеки или города, а не из сводки по всем аптекам.
+	scope := offerScope(params.PharmacyId, params.CityId)
+
+	var queryFilters []map[string]interface{}
+	var priceRange map[string]interface{}
+	if params.MinPrice > 0 || params.MaxPrice > 0 {
+		priceRange = map[string]interface{}{}
+		if params.MinPrice > 0 {
+			priceRange["gte"] = params.MinPrice
+		}
+		if params.MaxPrice > 0 {
+			priceRange["lte"] = params.MaxPrice
 		}
-		if maxPrice > 0 {
-			fmt.Println("maxPrice > 0")
-			priceRange["lte"] = maxPrice
+	}
+	if scope != nil {
+		offerFilters := []map[string]interface{}{scope}
+		if priceRange != nil {
+			offerFilters = append(offerFilters, map[string]interface{}{
+				"range": map[string]interface{}{"offers.price": priceRange},
+			})
+		}
+		if params.InStockOnly {
+			offerFilters = append(offerFilters, map[string]interface{}{
+				"range": map[string]interface{}{"offers.count": map[string]interface{}{"gt": 0}},
+			})
 		}
-		boolQuery["filter"] = []map[string]interface{}{
-			{
+		queryFilters = append(queryFilters, nestedOffers(offerFilters...))
+	} else {
+		if priceRange != nil {
+			queryFilters = append(queryFilters, map[string]interface{}{
 				"range": map[string]interface{}{
 					"price": priceRange,
 				},
-			},
+			})
+		}
+		if params.InStockOnly {
+			queryFilters = append(queryFilters, map[string]interface{}{
+				"range": map[string]interface{}{"count": map[string]interface{}{"gt": 0}},
+			})
 		}
 	}
+	// Продукт остается, если хотя бы в одной аптеке (в области выбора) есть остаток,
+	// который не истекает в ближайшие MinShelfLifeDays дней.
+	if params.MinShelfLifeDays > 0 {
+		offerFilters := []map[string]interface{}{
+			{"range": map[string]interface{}{"offers.count": map[string]interface{}{"gt": 0}}},
+			freshOffers(params.MinShelfLifeDays),
+		}
+		if scope != nil {
+			offerFilters = append(offerFilters, scope)
+		}
+		queryFilters = append(queryFilters, nestedOffers(offerFilters...))
+	}
+	if params.ActiveOnly {
+		queryFilters = append(queryFilters, map[string]interface{}{
+			"term": map[string]interface{}{"is_active": true},
+		})
+	}
+	if len(queryFilters) > 0 {
+		boolQuery["filter"] = queryFilters
+	}
+
+	// Выбранные фасеты применяются через post_filter, чтобы агрегации
+	// считались по всему результату запроса, а не только по выбранным значениям.
+	filters := facetFilters(params.Filter, scope)
 
 	query := map[string]interface{}{
 		"query": map[string]interface{}{
 			"bool": boolQuery,
 		},
+		"post_filter": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": facetFiltersExcept(filters, ""),
+			},
+		},
+		"aggs":    facetAggregations(filters, scope),
 		"_source": true,
-		"from":    from,
-		"size":    size,
+		"size":    params.Size,
 	}
 
 	// Добавление сортировки
-	switch strings.ToUpper(sort) {
+	var sort []map[string]interface{}
+	switch strings.ToUpper(params.Sort) {
 	case "PRICE_DESC":
-		query["sort"] = []map[string]interface{}{
-			{"price": map[string]interface{}{"order": "desc"}},
+		sort = []map[string]interface{}{
+			offerSort("price", "desc", scope),
 		}
 	case "PRICE_ASC":
-		query["sort"] = []map[string]interface{}{
-			{"price": map[string]interface{}{"order": "asc"}},
+		sort = []map[string]interface{}{
+			offerSort("price", "asc", scope),
 		}
 	case "COUNT_DESC":
-		query["sort"] = []map[string]interface{}{
-			{"count": map[string]interface{}{"order": "desc"}},
+		sort = []map[string]interface{}{
+			offerSort("count", "desc", scope),
 		}
 	case "COUNT_ASC":
-		query["sort"] = []map[string]interface{}{
-			{"count": map[string]interface{}{"order": "asc"}},
+		sort = []map[string]interface{}{
+			offerSort("count", "asc", scope),
 		}
 	case "DEFAULT", "":
 	default:
-		return nil, 0, fmt.Errorf("unknown sort option: %s", sort)
+		return elasticModels.ProductSearchResult{}, fmt.Errorf("unknown sort option: %s", params.Sort)
+	}
+
+	// В режиме курсора листаем через search_after внутри point in time,
+	// id служит тай-брейкером для стабильного порядка.
+	cursorMode := params.UseCursor || params.Cursor != ""
+	var cursor searchCursor
+	if cursorMode {
+		if params.Cursor != "" {
+			var err error
+			if cursor, err = decodeCursor(params.Cursor); err != nil {
+				return elasticModels.ProductSearchResult{}, err
+			}
+		} else {
+			pitId, err := es.openPointInTime(ctx)
+			if err != nil {
+				return elasticModels.ProductSearchResult{}, err
+			}
+			cursor.PitId = pitId
+		}
+		if len(sort) == 0 {
+			sort = append(sort, map[string]interface{}{"_score": map[string]interface{}{"order": "desc"}})
+		}
+		sort = append(sort, map[string]interface{}{"id": map[string]interface{}{"order": "asc"}})
+		query["sort"] = sort
+		hash, err := queryHash(query)
+		if err != nil {
+			return elasticModels.ProductSearchResult{}, err
+		}
+		// Курсор от другого запроса или сортировки дал бы бессмысленный search_after.
+		if params.Cursor != "" && cursor.QueryHash != hash {
+			return elasticModels.ProductSearchResult{}, fmt.Errorf("%w: cursor belongs to a different query", ErrInvalidCursor)
+		}
+		cursor.QueryHash = hash
+		query["pit"] = map[string]interface{}{"id": cursor.PitId, "keep_alive": pitKeepAlive}
+		if len(cursor.SearchAfter) > 0 {
+			query["search_after"] = cursor.SearchAfter
+		}
+	} else {
+		if params.From < 0 || params.From+params.Size > MaxResultWindow {
+			return elasticModels.ProductSearchResult{}, fmt.Errorf("%w: from %d, size %d", ErrResultWindowExceeded, params.From, params.Size)
+		}
+		query["from"] = params.From
+	}
+	if len(sort) > 0 {
+		query["sort"] = sort
 	}
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
-		return nil, 0, fmt.Errorf("failed to encode query: %w", err)
+		return elasticModels.ProductSearchResult{}, fmt.Errorf("failed to encode query: %w", err)
 	}
 
-	res, err := es.client.Search(
+	// Запрос с point in time не должен содержать индекс в пути.
+	options := []func(*esapi.SearchRequest){
 		es.client.Search.WithContext(ctx),
-		es.client.Search.WithIndex(IndexName),
 		es.client.Search.WithBody(&buf),
-	)
+	}
+	if !cursorMode {
+		options = append(options, es.client.Search.WithIndex(IndexName))
+	}
+	res, err := es.client.Search(options...)
 
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to perform search: %w", err)
+		return elasticModels.ProductSearchResult{}, fmt.Errorf("failed to perform search: %w", err)
 	}
 	defer res.Body.Close()
 
 	if res.IsError() {
-		return nil, 0, fmt.Errorf("search error: %s", res.String())
+		body := res.String()
+		if params.Cursor != "" && pitExpired(res.StatusCode, body) {
+			return elasticModels.ProductSearchResult{}, fmt.Errorf("%w: %s", ErrCursorExpired, body)
+		}
+		return elasticModels.ProductSearchResult{}, fmt.Errorf("search error: %s", body)
 	}
 
 	var result struct {
@@ -272,20 +389,48 @@ func (es *Elastic) ProductSearch(ctx context.Context, text string, from, size in
 			} `json:"total"`
 			Hits []struct {
 				Source elasticModels.Product `json:"_source"`
+				Sort   []json.RawMessage     `json:"sort"`
 			} `json:"hits"`
 		} `json:"hits"`
+		Aggregations map[string]facetAggregation `json:"aggregations"`
+		PitId        string                      `json:"pit_id"`
 	}
 
 	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return nil, 0, fmt.Errorf("failed to parse response: %w", err)
+		return elasticModels.ProductSearchResult{}, fmt.Errorf("failed to parse response: %w", err)
 	}
-	total := result.Hits.Total.Value
 	products := make([]elasticModels.Product, len(result.Hits.Hits))
 	for i, hit := range result.Hits.Hits {
 		products[i] = hit.Source
+		if scope != nil {
+			scopeProductOffers(&products[i], params.PharmacyId, params.CityId)
+		}
+	}
+
+	searchResult := elasticModels.ProductSearchResult{
+		Products: products,
+		Total:    result.Hits.Total.Value,
+		Facets:   parseFacets(result.Aggregations, scope != nil),
+	}
+
+	// Неполная страница означает, что результаты закончились: point in time
+	// больше не нужен и закрывается сразу, а не по истечении keep_alive.
+	if cursorMode {
+		pitId := result.PitId
+		if pitId == "" {
+			pitId = cursor.PitId
+		}
+		if params.Size > 0 && len(result.Hits.Hits) == params.Size {
+			next := searchCursor{PitId: pitId, SearchAfter: result.Hits.Hits[len(result.Hits.Hits)-1].Sort, QueryHash: cursor.QueryHash}
+			if searchResult.NextCursor, err = encodeCursor(next); err != nil {
+				return elasticModels.ProductSearchResult{}, err
+			}
+		} else if err := es.closePointInTime(ctx, pitId); err != nil {
+			log.Printf("Warning: %v", err)
+		}
 	}
 
-	return products, total, nil
+	return searchResult, nil
 }
 
 // ProductSearchIds выполняет поиск ID продуктов по тексту.
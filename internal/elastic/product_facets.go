@@ -0,0 +1,239 @@
+package elastic
+
+import (
+	"aurma_product/internal/models/elasticModels"
+	"fmt"
+)
+
+const facetSize = 20
+
+// Имена фасетов совпадают с именами агрегаций в запросе.
+const (
+	facetCompanyNames = "company_names"
+	facetMnns         = "mnns"
+	facetIssueForms   = "issue_forms"
+	facetAvailability = "availability"
+	facetPriceRanges  = "price_ranges"
+)
+
+// priceRanges границы корзин фасета цены в тенге.
+var priceRanges = []elasticModels.PriceRangeBucket{
+	{From: 0, To: 1000},
+	{From: 1000, To: 3000},
+	{From: 3000, To: 5000},
+	{From: 5000, To: 10000},
+	{From: 10000},
+}
+
+// facetFilters строит фильтры по выбранным значениям фасетов, сгруппированные по имени фасета.
+// При заданной области (аптека или город) наличие проверяется по предложениям этой области.
+func facetFilters(filter elasticModels.ProductSearchFilter, scope map[string]interface{}) map[string]map[string]interface{} {
+	filters := map[string]map[string]interface{}{}
+	if len(filter.CompanyNames) > 0 {
+		filters[facetCompanyNames] = map[string]interface{}{
+			"terms": map[string]interface{}{"company_name.raw": filter.CompanyNames},
+		}
+	}
+	if len(filter.Mnns) > 0 {
+		filters[facetMnns] = map[string]interface{}{
+			"terms": map[string]interface{}{"mnn.raw": filter.Mnns},
+		}
+	}
+	if len(filter.IssueForms) > 0 {
+		filters[facetIssueForms] = map[string]interface{}{
+			"terms": map[string]interface{}{"issue_form.raw": filter.IssueForms},
+		}
+	}
+	inStock := map[string]interface{}{
+		"range": map[string]interface{}{"count": map[string]interface{}{"gt": 0}},
+	}
+	if scope != nil {
+		inStock = nestedOffers(scope, map[string]interface{}{
+			"range": map[string]interface{}{"offers.count": map[string]interface{}{"gt": 0}},
+		})
+	}
+	switch filter.Availability {
+	case elasticModels.AvailabilityInStock:
+		filters[facetAvailability] = inStock
+	case elasticModels.AvailabilityOutOfStock:
+		filters[facetAvailability] = map[string]interface{}{
+			"bool": map[string]interface{}{"must_not": inStock},
+		}
+	}
+	return filters
+}
+
+// facetFiltersExcept возвращает фильтры всех фасетов, кроме указанного.
+// Так счетчики фасета не схлопываются до выбранного в нем значения.
+func facetFiltersExcept(filters map[string]map[string]interface{}, except string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(filters))
+	for name, f := range filters {
+		if name != except {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// facetAggregations строит агрегации для всех фасетов поиска. При заданной области
+// наличие и цены считаются по вложенным предложениям этой области, как фильтры и
+// сортировка, а reverse_nested возвращает счетчики к продуктам.
+func facetAggregations(filters map[string]map[string]interface{}, scope map[string]interface{}) map[string]interface{} {
+	wrap := func(name string, agg map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"filter": map[string]interface{}{
+				"bool": map[string]interface{}{"filter": facetFiltersExcept(filters, name)},
+			},
+			"aggs": map[string]interface{}{"values": agg},
+		}
+	}
+	terms := func(field string) map[string]interface{} {
+		return map[string]interface{}{
+			"terms": map[string]interface{}{"field": field, "size": facetSize},
+		}
+	}
+
+	ranges := make([]map[string]interface{}, 0, len(priceRanges))
+	for _, r := range priceRanges {
+		bucket := map[string]interface{}{"key": priceRangeKey(r), "from": r.From}
+		if r.To > 0 {
+			bucket["to"] = r.To
+		}
+		ranges = append(ranges, bucket)
+	}
+
+	aggs := map[string]interface{}{
+		facetCompanyNames: wrap(facetCompanyNames, terms("company_name.raw")),
+		facetMnns:         wrap(facetMnns, terms("mnn.raw")),
+		facetIssueForms:   wrap(facetIssueForms, terms("issue_form.raw")),
+	}
+	if scope == nil {
+		aggs[facetAvailability] = wrap(facetAvailability, map[string]interface{}{
+			"range": map[string]interface{}{
+				"field": "count",
+				"ranges": []map[string]interface{}{
+					{"key": elasticModels.AvailabilityOutOfStock, "to": 1},
+					{"key": elasticModels.AvailabilityInStock, "from": 1},
+				},
+			},
+		})
+		aggs[facetPriceRanges] = wrap(facetPriceRanges, map[string]interface{}{
+			"range": map[string]interface{}{"field": "price", "ranges": ranges},
+		})
+		return aggs
+	}
+
+	// scoped оставляет предложения области, подходящие под условие, и считает продукты,
+	// у которых они есть; sub задает вложенную агрегацию по этим предложениям.
+	scoped := func(condition map[string]interface{}, sub map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"nested": map[string]interface{}{"path": "offers"},
+			"aggs": map[string]interface{}{
+				"scoped": map[string]interface{}{
+					"filter": map[string]interface{}{
+						"bool": map[string]interface{}{"filter": []map[string]interface{}{scope, condition}},
+					},
+					"aggs": sub,
+				},
+			},
+		}
+	}
+	products := map[string]interface{}{"reverse_nested": map[string]interface{}{}}
+
+	// Продукты без предложения с остатком в области считаются отсутствующими:
+	// их число это разница между всеми документами фасета и найденными в наличии.
+	aggs[facetAvailability] = wrap(facetAvailability, scoped(
+		map[string]interface{}{"range": map[string]interface{}{"offers.count": map[string]interface{}{"gt": 0}}},
+		map[string]interface{}{"products": products},
+	))
+	// Продукт попадает в каждую корзину, где у него есть предложение области,
+	// так же как его находит фильтр цены.
+	aggs[facetPriceRanges] = wrap(facetPriceRanges, scoped(
+		map[string]interface{}{"range": map[string]interface{}{"offers.price": map[string]interface{}{"gt": 0}}},
+		map[string]interface{}{"ranges": map[string]interface{}{
+			"range": map[string]interface{}{"field": "offers.price", "ranges": ranges},
+			"aggs":  map[string]interface{}{"products": products},
+		}},
+	))
+	return aggs
+}
+
+func priceRangeKey(r elasticModels.PriceRangeBucket) string {
+	if r.To > 0 {
+		return fmt.Sprintf("%d-%d", r.From, r.To)
+	}
+	return fmt.Sprintf("%d-*", r.From)
+}
+
+// facetBucket корзина агрегации. Products заполнен для корзин по вложенным предложениям.
+type facetBucket struct {
+	Key      string `json:"key"`
+	DocCount int    `json:"doc_count"`
+	Products struct {
+		DocCount int `json:"doc_count"`
+	} `json:"products"`
+}
+
+// facetAggregation ответ Elasticsearch на одну обернутую агрегацию фасета.
+// Scoped заполнен для агрегаций по предложениям области.
+type facetAggregation struct {
+	DocCount int `json:"doc_count"`
+	Values   struct {
+		Buckets []facetBucket `json:"buckets"`
+		Scoped  struct {
+			Products struct {
+				DocCount int `json:"doc_count"`
+			} `json:"products"`
+			Ranges struct {
+				Buckets []facetBucket `json:"buckets"`
+			} `json:"ranges"`
+		} `json:"scoped"`
+	} `json:"values"`
+}
+
+// parseFacets переводит ответ агрегаций в ProductFacets. scoped сообщает, что наличие
+// и цены считались по предложениям области.
+func parseFacets(aggs map[string]facetAggregation, scoped bool) elasticModels.ProductFacets {
+	buckets := func(name string) []elasticModels.FacetBucket {
+		result := make([]elasticModels.FacetBucket, 0, len(aggs[name].Values.Buckets))
+		for _, b := range aggs[name].Values.Buckets {
+			result = append(result, elasticModels.FacetBucket{Value: b.Key, Count: b.DocCount})
+		}
+		return result
+	}
+
+	facets := elasticModels.ProductFacets{
+		CompanyNames: buckets(facetCompanyNames),
+		Mnns:         buckets(facetMnns),
+		IssueForms:   buckets(facetIssueForms),
+	}
+
+	counts := map[string]int{}
+	if scoped {
+		availability := aggs[facetAvailability]
+		facets.InStock = availability.Values.Scoped.Products.DocCount
+		facets.OutOfStock = availability.DocCount - facets.InStock
+		for _, b := range aggs[facetPriceRanges].Values.Scoped.Ranges.Buckets {
+			counts[b.Key] = b.Products.DocCount
+		}
+	} else {
+		for _, b := range aggs[facetAvailability].Values.Buckets {
+			switch b.Key {
+			case elasticModels.AvailabilityInStock:
+				facets.InStock = b.DocCount
+			case elasticModels.AvailabilityOutOfStock:
+				facets.OutOfStock = b.DocCount
+			}
+		}
+		for _, b := range aggs[facetPriceRanges].Values.Buckets {
+			counts[b.Key] = b.DocCount
+		}
+	}
+	facets.PriceRanges = make([]elasticModels.PriceRangeBucket, 0, len(priceRanges))
+	for _, r := range priceRanges {
+		r.Count = counts[priceRangeKey(r)]
+		facets.PriceRanges = append(facets.PriceRanges, r)
+	}
+
+	return facets
+}
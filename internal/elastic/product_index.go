@@ -0,0 +1,284 @@
+package elastic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrNoPreviousIndex возвращается, когда откатывать алиас некуда.
+var ErrNoPreviousIndex = errors.New("no previous index version")
+
+// IndexVersion версия индекса продуктов.
+type IndexVersion struct {
+	Name      string
+	Version   int
+	DocsCount int
+	Active    bool
+}
+
+// productIndexName возвращает имя индекса для версии.
+func productIndexName(version int) string {
+	return fmt.Sprintf("%s_v%d", IndexName, version)
+}
+
+// productIndexVersion извлекает номер версии из имени индекса.
+func productIndexVersion(name string) (int, bool) {
+	version, err := strconv.Atoi(strings.TrimPrefix(name, IndexName+"_v"))
+	if err != nil || !strings.HasPrefix(name, IndexName+"_v") {
+		return 0, false
+	}
+	return version, true
+}
+
+// ProductCreateIndex создает новую версию индекса продуктов. Если алиаса еще нет,
+// он сразу направляется на созданный индекс.
+func (es *Elastic) ProductCreateIndex() error {
+	ctx := context.Background()
+	name, err := es.ProductCreateIndexVersion(ctx)
+	if err != nil {
+		return err
+	}
+	active, err := es.ProductActiveIndex(ctx)
+	if err != nil {
+		return err
+	}
+	if active == "" {
+		if _, err := es.ProductSwitchAlias(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProductCreateIndexVersion создает индекс следующей версии и возвращает его имя.
+func (es *Elastic) ProductCreateIndexVersion(ctx context.Context) (string, error) {
+	versions, err := es.ProductIndexVersions(ctx)
+	if err != nil {
+		return "", err
+	}
+	next := 1
+	if len(versions) > 0 {
+		next = versions[len(versions)-1].Version + 1
+	}
+	name := productIndexName(next)
+	if err := es.createProductIndex(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// ProductIndexVersions возвращает версии индекса продуктов по возрастанию.
+func (es *Elastic) ProductIndexVersions(ctx context.Context) ([]IndexVersion, error) {
+	res, err := es.client.Cat.Indices(
+		es.client.Cat.Indices.WithContext(ctx),
+		es.client.Cat.Indices.WithIndex(IndexName+"_v*"),
+		es.client.Cat.Indices.WithH("index", "docs.count"),
+		es.client.Cat.Indices.WithFormat("json"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indices: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("list indices error: %s", res.String())
+	}
+
+	var indices []struct {
+		Index     string `json:"index"`
+		DocsCount string `json:"docs.count"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	active, err := es.ProductActiveIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]IndexVersion, 0, len(indices))
+	for _, index := range indices {
+		version, ok := productIndexVersion(index.Index)
+		if !ok {
+			continue
+		}
+		docsCount, _ := strconv.Atoi(index.DocsCount)
+		versions = append(versions, IndexVersion{
+			Name:      index.Index,
+			Version:   version,
+			DocsCount: docsCount,
+			Active:    index.Index == active,
+		})
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+
+	return versions, nil
+}
+
+// ProductActiveIndex возвращает индекс, на который указывает алиас, или пустую строку.
+func (es *Elastic) ProductActiveIndex(ctx context.Context) (string, error) {
+	res, err := es.client.Indices.GetAlias(
+		es.client.Indices.GetAlias.WithContext(ctx),
+		es.client.Indices.GetAlias.WithName(IndexName),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to get alias: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if res.IsError() {
+		return "", fmt.Errorf("get alias error: %s", res.String())
+	}
+
+	var aliases map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&aliases); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	for index := range aliases {
+		return index, nil
+	}
+	return "", nil
+}
+
+// ProductIndexCount обновляет индекс и возвращает количество документов в нем.
+func (es *Elastic) ProductIndexCount(ctx context.Context, index string) (int, error) {
+	refresh, err := es.client.Indices.Refresh(
+		es.client.Indices.Refresh.WithContext(ctx),
+		es.client.Indices.Refresh.WithIndex(index),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh index: %w", err)
+	}
+	refresh.Body.Close()
+	if refresh.IsError() {
+		return 0, fmt.Errorf("refresh index error: %s", refresh.String())
+	}
+
+	res, err := es.client.Count(
+		es.client.Count.WithContext(ctx),
+		es.client.Count.WithIndex(index),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count documents: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return 0, fmt.Errorf("count error: %s", res.String())
+	}
+
+	var result struct {
+		Count int `json:"count"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return result.Count, nil
+}
+
+// ProductSwitchAlias атомарно переключает алиас на указанный индекс и возвращает
+// индекс, на который алиас указывал раньше. Старый индекс product_list без версии,
+// если он есть, удаляется в той же операции, чтобы освободить имя под алиас.
+func (es *Elastic) ProductSwitchAlias(ctx context.Context, index string) (string, error) {
+	previous, err := es.ProductActiveIndex(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	actions := []map[string]interface{}{
+		{"add": map[string]interface{}{"index": index, "alias": IndexName, "is_write_index": true}},
+	}
+	if previous != "" {
+		actions = append(actions, map[string]interface{}{
+			"remove": map[string]interface{}{"index": previous, "alias": IndexName},
+		})
+	} else {
+		exists, err := es.client.Indices.Exists([]string{IndexName}, es.client.Indices.Exists.WithContext(ctx))
+		if err != nil {
+			return "", fmt.Errorf("failed to check legacy index: %w", err)
+		}
+		exists.Body.Close()
+		if exists.StatusCode == http.StatusOK {
+			actions = append(actions, map[string]interface{}{
+				"remove_index": map[string]interface{}{"index": IndexName},
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(map[string]interface{}{"actions": actions}); err != nil {
+		return "", fmt.Errorf("failed to encode alias actions: %w", err)
+	}
+
+	res, err := es.client.Indices.UpdateAliases(&buf, es.client.Indices.UpdateAliases.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to update aliases: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return "", fmt.Errorf("update aliases error: %s", res.String())
+	}
+	return previous, nil
+}
+
+// ProductRollbackIndex переключает алиас на указанную версию индекса, а при version == 0
+// на ближайшую версию, предшествующую активной. Возвращает имя нового активного индекса.
+func (es *Elastic) ProductRollbackIndex(ctx context.Context, version int) (string, error) {
+	versions, err := es.ProductIndexVersions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	target := ""
+	if version > 0 {
+		for _, v := range versions {
+			if v.Version == version {
+				target = v.Name
+			}
+		}
+		if target == "" {
+			return "", fmt.Errorf("index version %d not found", version)
+		}
+	} else {
+		for _, v := range versions {
+			if v.Active {
+				break
+			}
+			target = v.Name
+		}
+		if target == "" {
+			return "", ErrNoPreviousIndex
+		}
+	}
+
+	if _, err := es.ProductSwitchAlias(ctx, target); err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+// ProductDeleteIndex удаляет версию индекса продуктов.
+func (es *Elastic) ProductDeleteIndex(ctx context.Context, index string) error {
+	res, err := es.client.Indices.Delete([]string{index}, es.client.Indices.Delete.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete index: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("delete index error: %s", res.String())
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package elastic
+
+import (
+	"aurma_product/internal/models/elasticModels"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ProductMatch ищет продукты для сопоставления с оффером поставщика: нечеткий поиск
+// по названию, совпадение бренда с производителем поднимает продукт выше.
+// Ищет и среди неактивных продуктов.
+func (es *Elastic) ProductMatch(ctx context.Context, title, brand string, size int) ([]elasticModels.Product, error) {
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": map[string]interface{}{
+					"match": map[string]interface{}{
+						"name": map[string]interface{}{
+							"query":     title,
+							"fuzziness": "AUTO",
+							"operator":  "or",
+						},
+					},
+				},
+				"should": []map[string]interface{}{
+					{"match": map[string]interface{}{
+						"company_name": map[string]interface{}{"query": brand, "fuzziness": "AUTO"},
+					}},
+					{"match_phrase": map[string]interface{}{
+						"name": map[string]interface{}{"query": title, "boost": 2},
+					}},
+				},
+			},
+		},
+		"_source": []string{"id", "name", "slug", "company_name"},
+		"size":    size,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return nil, fmt.Errorf("failed to encode query: %w", err)
+	}
+
+	res, err := es.client.Search(
+		es.client.Search.WithContext(ctx),
+		es.client.Search.WithIndex(IndexName),
+		es.client.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform search: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("search error: %s", res.String())
+	}
+
+	var result struct {
+		Hits struct {
+			Hits []struct {
+				Source elasticModels.Product `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	products := make([]elasticModels.Product, len(result.Hits.Hits))
+	for i, hit := range result.Hits.Hits {
+		products[i] = hit.Source
+	}
+	return products, nil
+}
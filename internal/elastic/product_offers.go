@@ -0,0 +1,98 @@
+package elastic
+
+import (
+	"aurma_product/internal/models/elasticModels"
+	"fmt"
+)
+
+// offerScope возвращает фильтр вложенных предложений по аптеке или городу.
+// Аптека точнее города, поэтому при заданных обоих используется аптека.
+func offerScope(pharmacyId, cityId int) map[string]interface{} {
+	switch {
+	case pharmacyId > 0:
+		return map[string]interface{}{"term": map[string]interface{}{"offers.pharmacy_id": pharmacyId}}
+	case cityId > 0:
+		return map[string]interface{}{"term": map[string]interface{}{"offers.city_id": cityId}}
+	default:
+		return nil
+	}
+}
+
+// nestedOffers оборачивает фильтры по полям offers.* во вложенный запрос.
+func nestedOffers(filters ...map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"nested": map[string]interface{}{
+			"path": "offers",
+			"query": map[string]interface{}{
+				"bool": map[string]interface{}{"filter": filters},
+			},
+		},
+	}
+}
+
+// freshOffers фильтр предложений, срок годности которых не истекает раньше чем через
+// days дней. Предложения без срока годности проходят.
+func freshOffers(days int) map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"should": []map[string]interface{}{
+				{"range": map[string]interface{}{"offers.expiration_date": map[string]interface{}{"gte": fmt.Sprintf("now+%dd/d", days)}}},
+				{"bool": map[string]interface{}{"must_not": map[string]interface{}{"exists": map[string]interface{}{"field": "offers.expiration_date"}}}},
+			},
+			"minimum_should_match": 1,
+		},
+	}
+}
+
+// offerSort строит сортировку по цене или остатку. В области аптеки или города
+// берется минимальная ненулевая цена и суммарный остаток ее предложений.
+func offerSort(field, order string, scope map[string]interface{}) map[string]interface{} {
+	if scope == nil {
+		return map[string]interface{}{field: map[string]interface{}{"order": order}}
+	}
+	mode := "min"
+	filter := scope
+	if field == "count" {
+		mode = "sum"
+	} else {
+		// Нулевая цена означает, что цены нет, и не должна выигрывать сортировку.
+		filter = map[string]interface{}{
+			"bool": map[string]interface{}{"filter": []map[string]interface{}{
+				scope,
+				{"range": map[string]interface{}{"offers.price": map[string]interface{}{"gt": 0}}},
+			}},
+		}
+	}
+	return map[string]interface{}{
+		"offers." + field: map[string]interface{}{
+			"order": order,
+			"mode":  mode,
+			"nested": map[string]interface{}{
+				"path":   "offers",
+				"filter": filter,
+			},
+		},
+	}
+}
+
+// scopeProductOffers заменяет сводные цену и остаток продукта значениями
+// по предложениям выбранной аптеки или города.
+func scopeProductOffers(product *elasticModels.Product, pharmacyId, cityId int) {
+	price, maxPrice, count, inStock := 0, 0, 0, 0
+	for _, offer := range product.Offers {
+		if (pharmacyId > 0 && offer.PharmacyId != pharmacyId) || (pharmacyId <= 0 && offer.CityId != cityId) {
+			continue
+		}
+		if offer.Price > 0 && (price == 0 || offer.Price < price) {
+			price = offer.Price
+		}
+		if offer.Price > maxPrice {
+			maxPrice = offer.Price
+		}
+		if offer.Count > 0 {
+			count += offer.Count
+			inStock++
+		}
+	}
+	product.Price, product.MaxPrice, product.Count, product.PharmaciesInStock = price, maxPrice, count, inStock
+}
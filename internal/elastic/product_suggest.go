@@ -0,0 +1,148 @@
+package elastic
+
+import (
+	"aurma_product/internal/models/elasticModels"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	suggestDefaultSize = 10
+	suggestMaxSize     = 20
+	suggestTimeout     = "40ms"
+)
+
+// ProductSuggest возвращает подсказки по названиям, производителям и МНН
+// по edge-ngram подполям индекса. Документы загружаются без лишних полей.
+// Всего возвращается не больше size подсказок, типы чередуются.
+func (es *Elastic) ProductSuggest(ctx context.Context, text string, size int) ([]elasticModels.Suggestion, error) {
+	if size <= 0 {
+		size = suggestDefaultSize
+	}
+	if size > suggestMaxSize {
+		size = suggestMaxSize
+	}
+
+	match := func(field string) map[string]interface{} {
+		return map[string]interface{}{
+			"match": map[string]interface{}{
+				field: map[string]interface{}{"query": text, "operator": "and"},
+			},
+		}
+	}
+	terms := func(filterField, termsField string) map[string]interface{} {
+		return map[string]interface{}{
+			"filter": match(filterField),
+			"aggs": map[string]interface{}{
+				"values": map[string]interface{}{
+					"terms": map[string]interface{}{"field": termsField, "size": size},
+				},
+			},
+		}
+	}
+
+	// Запрос находит документы, совпавшие по любому из трех полей, чтобы агрегации
+	// производителей и МНН видели документы, совпавшие только по своему полю.
+	// Продукты в выдаче ограничиваются совпадением по названию через post_filter.
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []map[string]interface{}{
+					match("name.ngram"),
+					match("company_name.ngram"),
+					match("mnn.ngram"),
+				},
+				"minimum_should_match": 1,
+				"filter": []map[string]interface{}{
+					{"term": map[string]interface{}{"is_active": true}},
+				},
+			},
+		},
+		"post_filter": match("name.ngram"),
+		"aggs": map[string]interface{}{
+			elasticModels.SuggestionTypeProducer: terms("company_name.ngram", "company_name.raw"),
+			elasticModels.SuggestionTypeMnn:      terms("mnn.ngram", "mnn.raw"),
+		},
+		"_source":          []string{"id", "name", "slug"},
+		"size":             size,
+		"timeout":          suggestTimeout,
+		"track_total_hits": false,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return nil, fmt.Errorf("failed to encode query: %w", err)
+	}
+
+	res, err := es.client.Search(
+		es.client.Search.WithContext(ctx),
+		es.client.Search.WithIndex(IndexName),
+		es.client.Search.WithBody(&buf),
+		es.client.Search.WithRequestCache(true),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform suggest: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("suggest error: %s", res.String())
+	}
+
+	var result struct {
+		Hits struct {
+			Hits []struct {
+				Source elasticModels.Product `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+		Aggregations map[string]facetAggregation `json:"aggregations"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	products := make([]elasticModels.Suggestion, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		products = append(products, elasticModels.Suggestion{
+			Id:    hit.Source.Id,
+			Title: hit.Source.Title,
+			Type:  elasticModels.SuggestionTypeProduct,
+			Slug:  hit.Source.Slug,
+		})
+	}
+	groups := [][]elasticModels.Suggestion{products}
+	for _, suggestionType := range []string{elasticModels.SuggestionTypeProducer, elasticModels.SuggestionTypeMnn} {
+		buckets := result.Aggregations[suggestionType].Values.Buckets
+		group := make([]elasticModels.Suggestion, 0, len(buckets))
+		for _, b := range buckets {
+			group = append(group, elasticModels.Suggestion{
+				Title: b.Key,
+				Type:  suggestionType,
+			})
+		}
+		groups = append(groups, group)
+	}
+
+	return mergeSuggestions(size, groups...), nil
+}
+
+// mergeSuggestions чередует группы подсказок по одной, сохраняя порядок внутри группы,
+// пока не наберется size. Когда группа кончается, место занимают остальные.
+func mergeSuggestions(size int, groups ...[]elasticModels.Suggestion) []elasticModels.Suggestion {
+	suggestions := make([]elasticModels.Suggestion, 0, size)
+	for i := 0; len(suggestions) < size; i++ {
+		added := false
+		for _, group := range groups {
+			if i < len(group) && len(suggestions) < size {
+				suggestions = append(suggestions, group[i])
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	return suggestions
+}
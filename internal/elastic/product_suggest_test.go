@@ -0,0 +1,45 @@
+package elastic
+
+import (
+	"aurma_product/internal/models/elasticModels"
+	"reflect"
+	"testing"
+)
+
+func TestMergeSuggestions(t *testing.T) {
+	group := func(suggestionType string, titles ...string) []elasticModels.Suggestion {
+		var suggestions []elasticModels.Suggestion
+		for _, title := range titles {
+			suggestions = append(suggestions, elasticModels.Suggestion{Title: title, Type: suggestionType})
+		}
+		return suggestions
+	}
+	titles := func(suggestions []elasticModels.Suggestion) []string {
+		result := []string{}
+		for _, suggestion := range suggestions {
+			result = append(result, suggestion.Title)
+		}
+		return result
+	}
+
+	products := group(elasticModels.SuggestionTypeProduct, "p1", "p2", "p3", "p4")
+	producers := group(elasticModels.SuggestionTypeProducer, "c1", "c2")
+	mnns := group(elasticModels.SuggestionTypeMnn, "m1")
+	tests := []struct {
+		name string
+		size int
+		want []string
+	}{
+		{name: "cut to size", size: 5, want: []string{"p1", "c1", "m1", "p2", "c2"}},
+		{name: "exhausted groups leave room to others", size: 6, want: []string{"p1", "c1", "m1", "p2", "c2", "p3"}},
+		{name: "fewer than size", size: 20, want: []string{"p1", "c1", "m1", "p2", "c2", "p3", "p4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeSuggestions(tt.size, products, producers, mnns)
+			if !reflect.DeepEqual(titles(got), tt.want) {
+				t.Errorf("mergeSuggestions() = %v, want %v", titles(got), tt.want)
+			}
+		})
+	}
+}
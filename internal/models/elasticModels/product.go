@@ -1,32 +1,32 @@
 package elasticModels
 
-import "aurma_product/internal/models"
+import "time"
 
+// Product документ индекса product_list. Price — минимальная цена по аптекам,
+// Count — суммарный остаток во всех аптеках, Offers — предложения каждой аптеки.
 type Product struct {
-	Id          int    `json:"id" db:"id"`
-	Title       string `json:"title" db:"title"`
-	Price       int    `json:"price" db:"price"`
-	Slug        string `json:"slug" db:"slug"`
-	Count       int    `json:"count" db:"count"`
-	IsActive    bool   `json:"is_active" db:"is_active"`
-	CompanyName string `json:"company_name" db:"company_name"`
-	Barcode     string `json:"barcode" db:"barcode"`
-	Mnn         string `json:"mnn" db:"mnn"`
-	IssueForm   string `json:"issue_form" db:"issue_form"`
+	Id                int     `json:"id" db:"id"`
+	Title             string  `json:"name" db:"title"`
+	Price             int     `json:"price" db:"price"`
+	MaxPrice          int     `json:"max_price" db:"max_price"`
+	Slug              string  `json:"slug" db:"slug"`
+	Count             int     `json:"count" db:"count"`
+	PharmaciesInStock int     `json:"pharmacies_in_stock" db:"pharmacies_in_stock"`
+	IsActive          bool    `json:"is_active" db:"is_active"`
+	CompanyName       string  `json:"company_name" db:"company_name"`
+	Barcode           string  `json:"barcode" db:"barcode"`
+	Mnn               string  `json:"mnn" db:"mnn"`
+	IssueForm         string  `json:"issue_form" db:"issue_form"`
+	Offers            []Offer `json:"offers" db:"-"`
 }
 
-func (p *Product) ToProductDetail(images []models.ProductImage) models.ProductDetail {
-	return models.ProductDetail{
-		Id:          p.Id,
-		Title:       p.Title,
-		Price:       p.Price,
-		Slug:        p.Slug,
-		Count:       p.Count,
-		IsActive:    p.IsActive,
-		CompanyName: p.CompanyName,
-		Barcode:     p.Barcode,
-		Mnn:         p.Mnn,
-		IssueForm:   p.IssueForm,
-		Images:      images,
-	}
+// Offer предложение одной аптеки, вложенный документ offers.
+type Offer struct {
+	PharmacyId int        `json:"pharmacy_id"`
+	CityId     int        `json:"city_id,omitempty"`
+	Price      int        `json:"price"`
+	Count      int        `json:"count"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	// ExpirationDate срок годности партии, пусто если поставщик его не присылает.
+	ExpirationDate *time.Time `json:"expiration_date,omitempty"`
 }
@@ -0,0 +1,85 @@
+package elasticModels
+
+// Значения фильтра наличия для ProductSearchFilter.Availability.
+const (
+	AvailabilityInStock    = "IN_STOCK"
+	AvailabilityOutOfStock = "OUT_OF_STOCK"
+)
+
+// ProductSearchParams описывает запрос поиска по индексу product_list.
+// Пустой Text означает просмотр каталога только по фильтрам.
+// UseCursor или непустой Cursor включают постраничный обход через search_after,
+// в этом режиме From игнорируется. PharmacyId или CityId ограничивают цену,
+// остаток и сортировку предложениями этой аптеки или города. MinShelfLifeDays
+// оставляет продукты, у которых есть остаток со сроком годности не меньше этого
+// числа дней или без срока годности.
+type ProductSearchParams struct {
+	Text             string
+	From             int
+	Size             int
+	Sort             string
+	MinPrice         int
+	MaxPrice         int
+	ActiveOnly       bool
+	InStockOnly      bool
+	Filter           ProductSearchFilter
+	UseCursor        bool
+	Cursor           string
+	PharmacyId       int
+	CityId           int
+	MinShelfLifeDays int
+}
+
+// ProductSearchFilter содержит выбранные пользователем значения фасетов.
+type ProductSearchFilter struct {
+	CompanyNames []string
+	Mnns         []string
+	IssueForms   []string
+	Availability string
+}
+
+// ProductSearchResult результат поиска: найденные документы, их общее количество и фасеты.
+// NextCursor пуст, если следующей страницы нет или поиск шел без курсора.
+type ProductSearchResult struct {
+	Products   []Product
+	Total      int
+	Facets     ProductFacets
+	NextCursor string
+}
+
+// ProductFacets содержит корзины агрегаций для боковой панели фильтров.
+type ProductFacets struct {
+	CompanyNames []FacetBucket      `json:"company_names"`
+	Mnns         []FacetBucket      `json:"mnns"`
+	IssueForms   []FacetBucket      `json:"issue_forms"`
+	InStock      int                `json:"in_stock"`
+	OutOfStock   int                `json:"out_of_stock"`
+	PriceRanges  []PriceRangeBucket `json:"price_ranges"`
+}
+
+type FacetBucket struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// PriceRangeBucket диапазон цен [From, To); To == 0 означает диапазон без верхней границы.
+type PriceRangeBucket struct {
+	From  int `json:"from"`
+	To    int `json:"to"`
+	Count int `json:"count"`
+}
+
+// Типы подсказок автодополнения.
+const (
+	SuggestionTypeProduct  = "product"
+	SuggestionTypeProducer = "producer"
+	SuggestionTypeMnn      = "mnn"
+)
+
+// Suggestion облегченная подсказка для строки поиска.
+type Suggestion struct {
+	Id    int    `json:"id"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+	Slug  string `json:"slug"`
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+	"time"
+)
+
+// PricePoint цена и остаток продукта в аптеке с момента RecordedAt до следующей точки.
+type PricePoint struct {
+	ProductId  int       `db:"product_id"  json:"product_id"`
+	PharmacyId int       `db:"pharmacy_id" json:"pharmacy_id"`
+	Price      int       `db:"price"       json:"price"`
+	Count      int       `db:"count"       json:"count"`
+	RecordedAt time.Time `db:"recorded_at" json:"recorded_at"`
+}
+
+// PriceDay сводка цен продукта за день по аптекам, где он был в наличии.
+type PriceDay struct {
+	Day      time.Time `json:"day"`
+	MinPrice int       `json:"min_price"`
+	AvgPrice float64   `json:"avg_price"`
+	MaxPrice int       `json:"max_price"`
+}
+
+// PriceTrend история цены продукта за период: точки изменений, включая цены,
+// действовавшие на начало периода, и дневные сводки.
+type PriceTrend struct {
+	Points []PricePoint `json:"points"`
+	Days   []PriceDay   `json:"days"`
+}
+
+func (t *PriceTrend) ToPb() *pb.ProductPriceHistoryResponse {
+	response := &pb.ProductPriceHistoryResponse{
+		Points: make([]*pb.PricePoint, len(t.Points)),
+		Days:   make([]*pb.PriceDay, len(t.Days)),
+	}
+	for i, point := range t.Points {
+		response.Points[i] = &pb.PricePoint{
+			PharmacyId: int32(point.PharmacyId),
+			Price:      int32(point.Price),
+			Count:      int32(point.Count),
+			RecordedAt: point.RecordedAt.Format(time.RFC3339),
+		}
+	}
+	for i, day := range t.Days {
+		response.Days[i] = &pb.PriceDay{
+			Day:      day.Day.Format(time.DateOnly),
+			MinPrice: int32(day.MinPrice),
+			AvgPrice: day.AvgPrice,
+			MaxPrice: int32(day.MaxPrice),
+		}
+	}
+	return response
+}
+
+// PricePoints переводит изменения строк product_pharmacy в точки истории.
+// Скрытая строка записывается с нулевым остатком: купить ее нельзя.
+func PricePoints(changes []ImportChange) []PricePoint {
+	points := make([]PricePoint, len(changes))
+	for i, change := range changes {
+		points[i] = PricePoint{
+			ProductId:  change.ProductId,
+			PharmacyId: change.PharmacyId,
+			Price:      change.NewPrice,
+			Count:      change.NewCount,
+		}
+		if change.NewHidden {
+			points[i].Count = 0
+		}
+	}
+	return points
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"database/sql"
+	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+	"time"
+)
+
+const (
+	PriceHoldPending  = "pending"
+	PriceHoldApproved = "approved"
+	PriceHoldRejected = "rejected"
+)
+
+// PriceHold изменение строки product_pharmacy из фида, задержанное правилами проверки
+// до решения администратора.
+type PriceHold struct {
+	ImportChange
+	Id         int           `db:"id"          json:"id,omitempty"`
+	RunId      sql.NullInt64 `db:"run_id"      json:"run_id"`
+	Supplier   string        `db:"supplier"    json:"supplier"`
+	Reason     string        `db:"reason"      json:"reason"`
+	Status     string        `db:"status"      json:"status,omitempty"`
+	CreatedAt  time.Time     `db:"created_at"  json:"created_at"`
+	ResolvedAt sql.NullTime  `db:"resolved_at" json:"resolved_at"`
+}
+
+func (h *PriceHold) ToPb() *pb.PriceHold {
+	resolvedAt := ""
+	if h.ResolvedAt.Valid {
+		resolvedAt = h.ResolvedAt.Time.Format(time.RFC3339)
+	}
+	return &pb.PriceHold{
+		Id:            int32(h.Id),
+		RunId:         int32(h.RunId.Int64),
+		Supplier:      h.Supplier,
+		Sku:           h.SKU,
+		StoreId:       h.StoreId,
+		PharmacyId:    int32(h.PharmacyId),
+		ProductId:     int32(h.ProductId),
+		OldPrice:      int32(h.OldPrice),
+		NewPrice:      int32(h.NewPrice),
+		OldCount:      int32(h.OldCount),
+		NewCount:      int32(h.NewCount),
+		Reason:        h.Reason,
+		Status:        h.Status,
+		CreatedAt:     h.CreatedAt.Format(time.RFC3339),
+		ResolvedAt:    resolvedAt,
+		OldExpiration: formatDate(h.OldExpiration),
+		NewExpiration: formatDate(h.NewExpiration),
+	}
+}
@@ -39,8 +39,32 @@ type ProductDetail struct {
 
 // ProductElastic is the struct for product_list index in Elasticsearch
 
-func (p *ProductSearchWithData) ToProductElastic(price, count int) elasticModels.Product {
+func (p *ProductSearchWithData) ToProductElastic(aggregate ProductPharmacyAggregate, productPharmacies []ProductPharmacy) elasticModels.Product {
+	offers := make([]elasticModels.Offer, len(productPharmacies))
+	for i, productPharmacy := range productPharmacies {
+		offers[i] = productPharmacy.ToElasticOffer()
+	}
+
 	return elasticModels.Product{
+		Id:                p.Id,
+		Title:             p.Title,
+		Price:             aggregate.MinPrice,
+		MaxPrice:          aggregate.MaxPrice,
+		Slug:              p.Slug,
+		Count:             aggregate.TotalCount,
+		PharmaciesInStock: aggregate.PharmaciesInStock,
+		IsActive:          p.IsActive.Bool,
+		CompanyName:       p.CompanyName.String,
+		Barcode:           p.Barcode.String,
+		Mnn:               p.Mnn.String,
+		IssueForm:         p.IssueForm.String,
+		Offers:            offers,
+	}
+}
+
+// ToProductDetail собирает карточку продукта из данных MySQL, лучшей цены и суммарного остатка.
+func (p *ProductSearchWithData) ToProductDetail(price, count int, images []ProductImage) ProductDetail {
+	return ProductDetail{
 		Id:          p.Id,
 		Title:       p.Title,
 		Price:       price,
@@ -51,8 +75,27 @@ func (p *ProductSearchWithData) ToProductElastic(price, count int) elasticModels
 		Barcode:     p.Barcode.String,
 		Mnn:         p.Mnn.String,
 		IssueForm:   p.IssueForm.String,
+		Images:      images,
 	}
 }
+
+// ProductDetailFromElastic собирает карточку продукта из документа Elasticsearch.
+func ProductDetailFromElastic(p elasticModels.Product, images []ProductImage) ProductDetail {
+	return ProductDetail{
+		Id:          p.Id,
+		Title:       p.Title,
+		Price:       p.Price,
+		Slug:        p.Slug,
+		Count:       p.Count,
+		IsActive:    p.IsActive,
+		CompanyName: p.CompanyName,
+		Barcode:     p.Barcode,
+		Mnn:         p.Mnn,
+		IssueForm:   p.IssueForm,
+		Images:      images,
+	}
+}
+
 func (p *ProductDetail) ToPbProduct() *pb.Product {
 	images := make([]*pb.ProductImage, 0, len(p.Images))
 	if len(p.Images) > 0 {
@@ -0,0 +1,29 @@
+package models
+
+import (
+	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+	"time"
+)
+
+// BarcodeScan результат поиска продукта по отсканированному штрихкоду.
+type BarcodeScan struct {
+	Product ProductDetail
+	Gtin    string
+	Expiry  time.Time
+	Serial  string
+	Batch   string
+}
+
+func (b *BarcodeScan) ToPbBarcodeResponse() *pb.ProductBarcodeResponse {
+	expiry := ""
+	if !b.Expiry.IsZero() {
+		expiry = b.Expiry.Format(time.DateOnly)
+	}
+	return &pb.ProductBarcodeResponse{
+		Product:      b.Product.ToPbProduct(),
+		Gtin:         b.Gtin,
+		ExpiryDate:   expiry,
+		SerialNumber: b.Serial,
+		BatchNumber:  b.Batch,
+	}
+}
@@ -1,8 +1,80 @@
 package models
 
+import (
+	"aurma_product/internal/models/elasticModels"
+	"database/sql"
+	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+	"time"
+)
+
 type ProductPharmacy struct {
-	ProductId  int `db:"product_id"  json:"product_id"`
-	PharmacyId int `db:"pharmacy_id" json:"pharmacy_id"`
-	Price      int `db:"price"       json:"price"`
-	Count      int `db:"count"       json:"count"`
+	ProductId  int           `db:"product_id"  json:"product_id"`
+	Sku        string        `db:"sku"         json:"sku"`
+	PharmacyId int           `db:"pharmacy_id" json:"pharmacy_id"`
+	CityId     sql.NullInt64 `db:"city_id"     json:"city_id"`
+	Price      int           `db:"price"       json:"price"`
+	Count      int           `db:"count"       json:"count"`
+	UpdatedAt  sql.NullTime  `db:"updated_at"  json:"updated_at"`
+	IsHidden   bool          `db:"is_hidden"   json:"is_hidden"`
+	// ExpirationDate срок годности партии, пусто если поставщик его не присылает.
+	ExpirationDate sql.NullTime `db:"expiration_date" json:"expiration_date"`
+	// Supplier поставщик, чей фид ведет строку, пусто для строк без фида.
+	Supplier sql.NullString `db:"supplier" json:"supplier"`
+}
+
+// ShortDated сообщает, что срок годности истекает раньше чем через days дней.
+func (p *ProductPharmacy) ShortDated(days int) bool {
+	if !p.ExpirationDate.Valid {
+		return false
+	}
+	year, month, day := time.Now().Date()
+	return p.ExpirationDate.Time.Before(time.Date(year, month, day+days, 0, 0, 0, 0, p.ExpirationDate.Time.Location()))
+}
+
+func (p *ProductPharmacy) ToElasticOffer() elasticModels.Offer {
+	offer := elasticModels.Offer{
+		PharmacyId: p.PharmacyId,
+		CityId:     int(p.CityId.Int64),
+		Price:      p.Price,
+		Count:      p.Count,
+	}
+	if p.UpdatedAt.Valid {
+		updatedAt := p.UpdatedAt.Time
+		offer.UpdatedAt = &updatedAt
+	}
+	if p.ExpirationDate.Valid {
+		expirationDate := p.ExpirationDate.Time
+		offer.ExpirationDate = &expirationDate
+	}
+	return offer
+}
+
+// ProductPharmacyAggregate сводка предложений аптек по продукту для индекса.
+// Цены считаются по аптекам с остатком, а если таких нет, по всем аптекам.
+type ProductPharmacyAggregate struct {
+	ProductId         int `db:"product_id"          json:"product_id"`
+	MinPrice          int `db:"min_price"           json:"min_price"`
+	MaxPrice          int `db:"max_price"           json:"max_price"`
+	TotalCount        int `db:"total_count"         json:"total_count"`
+	PharmaciesInStock int `db:"pharmacies_in_stock" json:"pharmacies_in_stock"`
+}
+
+// ToPbOffer переводит предложение в gRPC, shortDatedDays задает порог флага ShortDated.
+func (p *ProductPharmacy) ToPbOffer(shortDatedDays int) *pb.ProductOffer {
+	updatedAt := ""
+	if p.UpdatedAt.Valid {
+		updatedAt = p.UpdatedAt.Time.Format(time.RFC3339)
+	}
+	expirationDate := ""
+	if p.ExpirationDate.Valid {
+		expirationDate = p.ExpirationDate.Time.Format(time.DateOnly)
+	}
+	return &pb.ProductOffer{
+		PharmacyId:     int32(p.PharmacyId),
+		Price:          int32(p.Price),
+		Count:          int32(p.Count),
+		UpdatedAt:      updatedAt,
+		ExpirationDate: expirationDate,
+		ShortDated:     p.ShortDated(shortDatedDays),
+	}
 }
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"aurma_product/internal/models/elasticModels"
+	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+)
+
+// ProductSearchResult результат поиска с карточками продуктов и фасетами.
+type ProductSearchResult struct {
+	Products   []ProductDetail
+	Total      int
+	Facets     elasticModels.ProductFacets
+	NextCursor string
+}
+
+func (r *ProductSearchResult) ToPbSearchResponse() *pb.ProductSearchResponse {
+	products := make([]*pb.Product, len(r.Products))
+	for i, pd := range r.Products {
+		products[i] = pd.ToPbProduct()
+	}
+
+	return &pb.ProductSearchResponse{
+		Products:   products,
+		TotalCount: int32(r.Total),
+		Facets:     toPbFacets(r.Facets),
+		NextCursor: r.NextCursor,
+	}
+}
+
+func toPbFacets(f elasticModels.ProductFacets) *pb.ProductFacets {
+	buckets := func(in []elasticModels.FacetBucket) []*pb.FacetBucket {
+		out := make([]*pb.FacetBucket, 0, len(in))
+		for _, b := range in {
+			out = append(out, &pb.FacetBucket{Value: b.Value, Count: int32(b.Count)})
+		}
+		return out
+	}
+
+	priceRanges := make([]*pb.PriceRangeBucket, 0, len(f.PriceRanges))
+	for _, r := range f.PriceRanges {
+		priceRanges = append(priceRanges, &pb.PriceRangeBucket{
+			From:  int32(r.From),
+			To:    int32(r.To),
+			Count: int32(r.Count),
+		})
+	}
+
+	return &pb.ProductFacets{
+		CompanyNames: buckets(f.CompanyNames),
+		Mnns:         buckets(f.Mnns),
+		IssueForms:   buckets(f.IssueForms),
+		InStock:      int32(f.InStock),
+		OutOfStock:   int32(f.OutOfStock),
+		PriceRanges:  priceRanges,
+	}
+}
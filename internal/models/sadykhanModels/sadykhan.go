@@ -1,5 +1,7 @@
 package sadykhanModels
 
+import "strings"
+
 type Catalog struct {
 	Company  string  `xml:"company" json:"company"`
 	Merchant string  `xml:"merchantid" json:"merchant_id"`
@@ -10,23 +12,42 @@ type Offer struct {
 	SKU            string         `xml:"sku,attr" json:"sku"`
 	Model          string         `xml:"model" json:"model"`
 	Brand          string         `xml:"brand" json:"brand"`
-	ExpirationDate string         `xml:"-" json:"expiration_date,omitempty"`
+	ExpirationDate string         `xml:"expiration_date" json:"expiration_date,omitempty"`
 	Barcodes       Barcodes       `xml:"barcodes" json:"barcodes"`
-	Availabilities Availabilities `xml:"availabilities" json:"availabilities"`
-	CityPrices     CityPrices     `xml:"city_prices" json:"city_prices"`
+	Availabilities []Availability `xml:"availabilities>availability" json:"availabilities"`
+	CityPrices     []CityPrice    `xml:"city_prices>city_price" json:"city_prices"`
 }
 
 type Barcodes struct {
 	Codes []string `xml:"barcode" json:"barcodes"`
 }
 
-type Availabilities struct {
-	Available    string `xml:"availability>available,attr" json:"available"`
-	StoreID      string `xml:"availability>storeId,attr" json:"store_id"`
-	Availability int    `xml:"availability" json:"availability"`
+// Availability остаток оффера на одном складе (аптеке) поставщика.
+type Availability struct {
+	Available    string `xml:"available,attr" json:"available"`
+	StoreID      string `xml:"storeId,attr" json:"store_id"`
+	Availability int    `xml:",chardata" json:"availability"`
+}
+
+// Count возвращает остаток с учетом флага наличия.
+func (a Availability) Count() int {
+	if strings.EqualFold(a.Available, "YES") {
+		return a.Availability
+	}
+	return 0
+}
+
+// CityPrice цена оффера в одном городе.
+type CityPrice struct {
+	CityID    int `xml:"city_id,attr" json:"city_id"`
+	CityPrice int `xml:",chardata" json:"city_price"`
 }
 
-type CityPrices struct {
-	CityID    int `xml:"city_price>city_id" json:"city_id"`
-	CityPrice int `xml:"city_price" json:"city_price"`
+// PriceByCity возвращает цены оффера по городам.
+func (o Offer) PriceByCity() map[int]int {
+	prices := make(map[int]int, len(o.CityPrices))
+	for _, cityPrice := range o.CityPrices {
+		prices[cityPrice.CityID] = cityPrice.CityPrice
+	}
+	return prices
 }
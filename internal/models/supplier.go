@@ -0,0 +1,10 @@
+package models
+
+import "database/sql"
+
+// SupplierStore склад поставщика, привязанный к нашей аптеке.
+type SupplierStore struct {
+	StoreId    string        `db:"store_id"    json:"store_id"`
+	PharmacyId int           `db:"pharmacy_id" json:"pharmacy_id"`
+	CityId     sql.NullInt64 `db:"city_id"     json:"city_id"`
+}
@@ -0,0 +1,221 @@
+package models
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// PriceChange изменение цены строки с процентом относительно старой цены.
+// Percent пуст, если старая цена была нулевой.
+type PriceChange struct {
+	ImportRow
+	OldPrice int      `json:"old_price"`
+	NewPrice int      `json:"new_price"`
+	Percent  *float64 `json:"percent"`
+}
+
+// StockChange изменение остатка строки.
+type StockChange struct {
+	ImportRow
+	OldCount int `json:"old_count"`
+	NewCount int `json:"new_count"`
+}
+
+// ImportDiff отчет о том, что изменил бы фид поставщика.
+type ImportDiff struct {
+	PriceIncreases []PriceChange   `json:"price_increases"`
+	PriceDecreases []PriceChange   `json:"price_decreases"`
+	StockChanges   []StockChange   `json:"stock_changes"`
+	Unknown        []ImportRow     `json:"unknown"`
+	Missing        []ImportRow     `json:"missing"`
+	Failed         []ImportFailure `json:"failed"`
+	Held           []PriceHold     `json:"held"`
+}
+
+// NewImportDiff собирает отчет из изменений, итога сверки и строк, которых нет в фиде.
+func NewImportDiff(changes []ImportChange, result ImportResult, missing []ImportRow) ImportDiff {
+	diff := ImportDiff{Unknown: result.Unknown, Missing: missing, Failed: result.Failed, Held: result.Held}
+
+	for _, change := range changes {
+		if change.OldPrice != change.NewPrice {
+			priceChange := PriceChange{ImportRow: change.ImportRow, OldPrice: change.OldPrice, NewPrice: change.NewPrice}
+			if change.OldPrice != 0 {
+				percent := float64(change.NewPrice-change.OldPrice) / float64(change.OldPrice) * 100
+				priceChange.Percent = &percent
+			}
+			if change.NewPrice > change.OldPrice {
+				diff.PriceIncreases = append(diff.PriceIncreases, priceChange)
+			} else {
+				diff.PriceDecreases = append(diff.PriceDecreases, priceChange)
+			}
+		}
+		if change.OldCount != change.NewCount {
+			diff.StockChanges = append(diff.StockChanges, StockChange{ImportRow: change.ImportRow, OldCount: change.OldCount, NewCount: change.NewCount})
+		}
+	}
+
+	// Сначала самые сильные изменения цены.
+	sort.SliceStable(diff.PriceIncreases, func(i, j int) bool {
+		return percentOf(diff.PriceIncreases[i]) > percentOf(diff.PriceIncreases[j])
+	})
+	sort.SliceStable(diff.PriceDecreases, func(i, j int) bool {
+		return percentOf(diff.PriceDecreases[i]) < percentOf(diff.PriceDecreases[j])
+	})
+	sortRows := func(rows []ImportRow) {
+		sort.SliceStable(rows, func(i, j int) bool {
+			if rows[i].SKU != rows[j].SKU {
+				return rows[i].SKU < rows[j].SKU
+			}
+			return rows[i].PharmacyId < rows[j].PharmacyId
+		})
+	}
+	sortRows(diff.Unknown)
+	sortRows(diff.Missing)
+	sort.SliceStable(diff.StockChanges, func(i, j int) bool {
+		return diff.StockChanges[i].SKU < diff.StockChanges[j].SKU
+	})
+
+	return diff
+}
+
+// percentOf возвращает процент изменения, для нулевой старой цены считая его максимальным.
+func percentOf(change PriceChange) float64 {
+	if change.Percent == nil {
+		return 1e9
+	}
+	return *change.Percent
+}
+
+// WriteJSON пишет отчет в JSON.
+func (d ImportDiff) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(d); err != nil {
+		return fmt.Errorf("failed to encode diff: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV пишет отчет одной таблицей, тип изменения в колонке change.
+func (d ImportDiff) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	records := [][]string{{
+		"change", "sku", "store_id", "pharmacy_id", "old_price", "new_price", "percent", "old_count", "new_count", "error",
+	}}
+
+	row := func(change string, r ImportRow) []string {
+		pharmacyId := ""
+		if r.PharmacyId != 0 {
+			pharmacyId = strconv.Itoa(r.PharmacyId)
+		}
+		return []string{change, r.SKU, r.StoreId, pharmacyId, "", "", "", "", "", ""}
+	}
+	priceRecord := func(change string, p PriceChange) []string {
+		record := row(change, p.ImportRow)
+		record[4] = strconv.Itoa(p.OldPrice)
+		record[5] = strconv.Itoa(p.NewPrice)
+		if p.Percent != nil {
+			record[6] = strconv.FormatFloat(*p.Percent, 'f', 2, 64)
+		}
+		return record
+	}
+
+	for _, p := range d.PriceIncreases {
+		records = append(records, priceRecord("price_increase", p))
+	}
+	for _, p := range d.PriceDecreases {
+		records = append(records, priceRecord("price_decrease", p))
+	}
+	for _, s := range d.StockChanges {
+		record := row("stock", s.ImportRow)
+		record[7] = strconv.Itoa(s.OldCount)
+		record[8] = strconv.Itoa(s.NewCount)
+		records = append(records, record)
+	}
+	for _, r := range d.Unknown {
+		records = append(records, row("unknown", r))
+	}
+	for _, r := range d.Missing {
+		records = append(records, row("missing", r))
+	}
+	for _, f := range d.Failed {
+		record := row("failed", f.ImportRow)
+		record[9] = f.Error
+		records = append(records, record)
+	}
+	for _, h := range d.Held {
+		record := row("held", h.ImportRow)
+		record[4] = strconv.Itoa(h.OldPrice)
+		record[5] = strconv.Itoa(h.NewPrice)
+		record[7] = strconv.Itoa(h.OldCount)
+		record[8] = strconv.Itoa(h.NewCount)
+		record[9] = h.Reason
+		records = append(records, record)
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("failed to write diff: %w", err)
+	}
+	return nil
+}
+
+func (d ImportDiff) ToPb() *pb.SupplierDryRunResponse {
+	rows := func(rows []ImportRow) []*pb.SupplierImportRow {
+		result := make([]*pb.SupplierImportRow, len(rows))
+		for i, r := range rows {
+			result[i] = &pb.SupplierImportRow{Sku: r.SKU, StoreId: r.StoreId, PharmacyId: int32(r.PharmacyId)}
+		}
+		return result
+	}
+	prices := func(changes []PriceChange) []*pb.SupplierPriceChange {
+		result := make([]*pb.SupplierPriceChange, len(changes))
+		for i, p := range changes {
+			result[i] = &pb.SupplierPriceChange{
+				Sku:        p.SKU,
+				StoreId:    p.StoreId,
+				PharmacyId: int32(p.PharmacyId),
+				OldPrice:   int32(p.OldPrice),
+				NewPrice:   int32(p.NewPrice),
+				Percent:    p.Percent,
+			}
+		}
+		return result
+	}
+
+	response := &pb.SupplierDryRunResponse{
+		PriceIncreases: prices(d.PriceIncreases),
+		PriceDecreases: prices(d.PriceDecreases),
+		StockChanges:   make([]*pb.SupplierStockChange, len(d.StockChanges)),
+		Unknown:        rows(d.Unknown),
+		Missing:        rows(d.Missing),
+		Failed:         make([]*pb.SupplierImportFailure, len(d.Failed)),
+		Held:           make([]*pb.PriceHold, len(d.Held)),
+	}
+	for i, s := range d.StockChanges {
+		response.StockChanges[i] = &pb.SupplierStockChange{
+			Sku:        s.SKU,
+			StoreId:    s.StoreId,
+			PharmacyId: int32(s.PharmacyId),
+			OldCount:   int32(s.OldCount),
+			NewCount:   int32(s.NewCount),
+		}
+	}
+	for i, f := range d.Failed {
+		response.Failed[i] = &pb.SupplierImportFailure{
+			Sku:        f.SKU,
+			StoreId:    f.StoreId,
+			PharmacyId: int32(f.PharmacyId),
+			Line:       int32(f.Line),
+			Error:      f.Error,
+		}
+	}
+	for i := range d.Held {
+		response.Held[i] = d.Held[i].ToPb()
+	}
+	return response
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// ImportRow строка product_pharmacy, которой коснулся импорт фида.
+type ImportRow struct {
+	SKU        string `db:"sku"         json:"sku"`
+	StoreId    string `db:"store_id"    json:"store_id,omitempty"`
+	PharmacyId int    `db:"pharmacy_id" json:"pharmacy_id,omitempty"`
+}
+
+// ImportFailure строка фида, которую не удалось применить.
+type ImportFailure struct {
+	ImportRow
+	Line  int    `db:"line"  json:"line,omitempty"`
+	Error string `db:"error" json:"error"`
+}
+
+// ImportResult итог импорта фида поставщика.
+type ImportResult struct {
+	// RunId запись запуска в истории импортов.
+	RunId     int             `json:"run_id,omitempty"`
+	Updated   []ImportRow     `json:"updated"`
+	Unchanged []ImportRow     `json:"unchanged"`
+	Unknown   []ImportRow     `json:"unknown"`
+	Failed    []ImportFailure `json:"failed"`
+	// Withdrawn строки поставщика, снятые с продажи полной сверкой, так как их не было в фиде.
+	Withdrawn []ImportRow `json:"withdrawn,omitempty"`
+	// Held изменения, задержанные правилами проверки до решения администратора.
+	Held []PriceHold `json:"held,omitempty"`
+}
+
+// SyncOptions настройки полной сверки фида поставщика.
+type SyncOptions struct {
+	// Hide скрывает отсутствующие в фиде строки вместо обнуления остатка.
+	Hide bool
+	// MaxWithdrawPercent доля строк поставщика в процентах, выше которой сверка прерывается.
+	// 0 означает порог из конфигурации.
+	MaxWithdrawPercent float64
+}
+
+// ImportChange изменение строки product_pharmacy по фиду.
+type ImportChange struct {
+	ImportRow
+	ProductId int  `db:"product_id" json:"product_id"`
+	OldPrice  int  `db:"old_price"  json:"old_price"`
+	NewPrice  int  `db:"new_price"  json:"new_price"`
+	OldCount  int  `db:"old_count"  json:"old_count"`
+	NewCount  int  `db:"new_count"  json:"new_count"`
+	OldHidden bool `db:"old_hidden" json:"old_hidden,omitempty"`
+	NewHidden bool `db:"new_hidden" json:"new_hidden,omitempty"`
+	// OldExpiration и NewExpiration срок годности до и после изменения, пустой если его не было.
+	OldExpiration sql.NullTime `db:"old_expiration" json:"old_expiration"`
+	NewExpiration sql.NullTime `db:"new_expiration" json:"new_expiration"`
+}
+
+// ExpirationTracked сообщает, что изменение знает срок годности строки. У изменений,
+// записанных до появления сроков в истории, оба срока пусты, и срок они не трогают.
+func (c ImportChange) ExpirationTracked() bool {
+	return c.OldExpiration.Valid || c.NewExpiration.Valid
+}
+
+// formatDate переводит срок годности в строку gRPC, пустую если срока нет.
+func formatDate(date sql.NullTime) string {
+	if !date.Valid {
+		return ""
+	}
+	return date.Time.Format(time.DateOnly)
+}
+
+// SameDate сообщает, что оба срока годности пусты или приходятся на один день.
+func SameDate(a, b sql.NullTime) bool {
+	if !a.Valid || !b.Valid {
+		return a.Valid == b.Valid
+	}
+	return a.Time.Format(time.DateOnly) == b.Time.Format(time.DateOnly)
+}
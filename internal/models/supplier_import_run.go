@@ -0,0 +1,112 @@
+package models
+
+import (
+	"database/sql"
+	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+	"time"
+)
+
+const (
+	ImportModeImport = "import"
+	// ImportModeSync полная сверка: отсутствующие в фиде строки снимаются с продажи.
+	ImportModeSync = "sync"
+	// ImportModeRollback откат другого запуска к прежним ценам и остаткам.
+	ImportModeRollback = "rollback"
+	// ImportModeApprove одобрение задержанного изменения, откатывается как импорт.
+	ImportModeApprove = "approve"
+
+	ImportRunRunning = "running"
+	ImportRunSuccess = "success"
+	ImportRunFailed  = "failed"
+)
+
+// ImportRun запуск импорта фида поставщика. RolledBackBy ссылается на запуск, которым его откатили.
+type ImportRun struct {
+	Id           int             `db:"id"             json:"id"`
+	Supplier     string          `db:"supplier"       json:"supplier"`
+	Source       string          `db:"source"         json:"source"`
+	FeedHash     string          `db:"feed_hash"      json:"feed_hash"`
+	Mode         string          `db:"mode"           json:"mode"`
+	Status       string          `db:"status"         json:"status"`
+	Error        sql.NullString  `db:"error"          json:"error"`
+	Updated      int             `db:"updated"        json:"updated"`
+	Unchanged    int             `db:"unchanged"      json:"unchanged"`
+	Unknown      int             `db:"unknown"        json:"unknown"`
+	Failed       int             `db:"failed"         json:"failed"`
+	Withdrawn    int             `db:"withdrawn"      json:"withdrawn"`
+	Held         int             `db:"held"           json:"held"`
+	StartedAt    time.Time       `db:"started_at"     json:"started_at"`
+	FinishedAt   sql.NullTime    `db:"finished_at"    json:"finished_at"`
+	RolledBackBy sql.NullInt64   `db:"rolled_back_by" json:"rolled_back_by"`
+	Changes      []ImportChange  `db:"-"              json:"changes,omitempty"`
+	Failures     []ImportFailure `db:"-"              json:"failures,omitempty"`
+}
+
+// Finish заполняет итог запуска по результату импорта.
+func (r *ImportRun) Finish(result ImportResult, err error) {
+	r.Updated = len(result.Updated)
+	r.Unchanged = len(result.Unchanged)
+	r.Unknown = len(result.Unknown)
+	r.Failed = len(result.Failed)
+	r.Withdrawn = len(result.Withdrawn)
+	r.Held = len(result.Held)
+	r.FinishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	r.Status = ImportRunSuccess
+	if err != nil {
+		r.Status = ImportRunFailed
+		r.Error = sql.NullString{String: err.Error(), Valid: true}
+	}
+}
+
+func (r *ImportRun) ToPb() *pb.SupplierImportRun {
+	finishedAt := ""
+	if r.FinishedAt.Valid {
+		finishedAt = r.FinishedAt.Time.Format(time.RFC3339)
+	}
+	run := &pb.SupplierImportRun{
+		Id:           int32(r.Id),
+		Supplier:     r.Supplier,
+		Source:       r.Source,
+		FeedHash:     r.FeedHash,
+		Mode:         r.Mode,
+		Status:       r.Status,
+		Error:        r.Error.String,
+		Updated:      int32(r.Updated),
+		Unchanged:    int32(r.Unchanged),
+		Unknown:      int32(r.Unknown),
+		Failed:       int32(r.Failed),
+		Withdrawn:    int32(r.Withdrawn),
+		Held:         int32(r.Held),
+		StartedAt:    r.StartedAt.Format(time.RFC3339),
+		FinishedAt:   finishedAt,
+		RolledBackBy: int32(r.RolledBackBy.Int64),
+		Changes:      make([]*pb.SupplierImportChange, len(r.Changes)),
+		Failures:     make([]*pb.SupplierImportFailure, len(r.Failures)),
+	}
+	for i, change := range r.Changes {
+		run.Changes[i] = &pb.SupplierImportChange{
+			Sku:           change.SKU,
+			StoreId:       change.StoreId,
+			PharmacyId:    int32(change.PharmacyId),
+			ProductId:     int32(change.ProductId),
+			OldPrice:      int32(change.OldPrice),
+			NewPrice:      int32(change.NewPrice),
+			OldCount:      int32(change.OldCount),
+			NewCount:      int32(change.NewCount),
+			OldHidden:     change.OldHidden,
+			NewHidden:     change.NewHidden,
+			OldExpiration: formatDate(change.OldExpiration),
+			NewExpiration: formatDate(change.NewExpiration),
+		}
+	}
+	for i, failure := range r.Failures {
+		run.Failures[i] = &pb.SupplierImportFailure{
+			Sku:        failure.SKU,
+			StoreId:    failure.StoreId,
+			PharmacyId: int32(failure.PharmacyId),
+			Line:       int32(failure.Line),
+			Error:      failure.Error,
+		}
+	}
+	return run
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"database/sql"
+	pb "github.com/antibomberman/aurma-protos/gen/go/product"
+	"time"
+)
+
+const (
+	MatchStatusPending  = "pending"
+	MatchStatusAccepted = "accepted"
+	MatchStatusRejected = "rejected"
+
+	// MatchSourceBarcode кандидат найден по точному совпадению штрихкода.
+	MatchSourceBarcode = "barcode"
+	// MatchSourceSearch кандидат найден нечетким поиском по названию и бренду.
+	MatchSourceSearch = "search"
+)
+
+// SupplierMatch оффер поставщика с неизвестным SKU, ожидающий сопоставления с продуктом.
+type SupplierMatch struct {
+	Id         int              `db:"id"          json:"id"`
+	Supplier   string           `db:"supplier"    json:"supplier"`
+	Sku        string           `db:"sku"         json:"sku"`
+	StoreId    string           `db:"store_id"    json:"store_id"`
+	PharmacyId int              `db:"pharmacy_id" json:"pharmacy_id"`
+	Title      string           `db:"title"       json:"title"`
+	Brand      string           `db:"brand"       json:"brand"`
+	Barcodes   string           `db:"barcodes"    json:"barcodes"`
+	Price      int              `db:"price"       json:"price"`
+	Count      int              `db:"count"       json:"count"`
+	Status     string           `db:"status"      json:"status"`
+	ProductId  sql.NullInt64    `db:"product_id"  json:"product_id"`
+	CreatedAt  time.Time        `db:"created_at"  json:"created_at"`
+	UpdatedAt  time.Time        `db:"updated_at"  json:"updated_at"`
+	Candidates []MatchCandidate `db:"-"         json:"candidates"`
+}
+
+// MatchCandidate продукт-кандидат для записи очереди с уверенностью от 0 до 1.
+type MatchCandidate struct {
+	Id         int     `db:"id"         json:"id"`
+	MatchId    int     `db:"match_id"   json:"match_id"`
+	ProductId  int     `db:"product_id" json:"product_id"`
+	Title      string  `db:"title"      json:"title"`
+	Source     string  `db:"source"     json:"source"`
+	Confidence float64 `db:"confidence" json:"confidence"`
+}
+
+func (m *SupplierMatch) ToPb() *pb.SupplierMatch {
+	match := &pb.SupplierMatch{
+		Id:         int32(m.Id),
+		Supplier:   m.Supplier,
+		Sku:        m.Sku,
+		StoreId:    m.StoreId,
+		PharmacyId: int32(m.PharmacyId),
+		Title:      m.Title,
+		Brand:      m.Brand,
+		Barcodes:   m.Barcodes,
+		Price:      int32(m.Price),
+		Count:      int32(m.Count),
+		Status:     m.Status,
+		ProductId:  int32(m.ProductId.Int64),
+		CreatedAt:  m.CreatedAt.Format(time.RFC3339),
+		Candidates: make([]*pb.SupplierMatchCandidate, len(m.Candidates)),
+	}
+	for i, candidate := range m.Candidates {
+		match.Candidates[i] = &pb.SupplierMatchCandidate{
+			ProductId:  int32(candidate.ProductId),
+			Title:      candidate.Title,
+			Source:     candidate.Source,
+			Confidence: candidate.Confidence,
+		}
+	}
+	return match
+}
@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"aurma_product/internal/models"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"strings"
+)
+
+// ErrImportRunRolledBack возвращается при повторном откате запуска импорта.
+var ErrImportRunRolledBack = errors.New("import run already rolled back")
+
+// importRunInsertBatch сколько строк изменений вставляется одним запросом.
+const importRunInsertBatch = 500
+
+type importRunRepository struct {
+	db *sqlx.DB
+}
+
+// NewImportRunRepository создает новый экземпляр ImportRunRepository.
+func NewImportRunRepository(db *sqlx.DB) ImportRunRepository {
+	return &importRunRepository{db: db}
+}
+
+// Start записывает начало запуска импорта и возвращает его идентификатор.
+func (r *importRunRepository) Start(run models.ImportRun) (int, error) {
+	result, err := r.db.Exec(`
+		INSERT INTO supplier_import_runs (supplier, source, mode, status, started_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, run.Supplier, run.Source, run.Mode, models.ImportRunRunning, run.StartedAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert import run: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get import run id: %w", err)
+	}
+	return int(id), nil
+}
+
+// Finish сохраняет итог запуска вместе с изменениями и ошибками строк одной транзакцией.
+func (r *importRunRepository) Finish(run models.ImportRun, changes []models.ImportChange, failures []models.ImportFailure) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		UPDATE supplier_import_runs SET feed_hash = ?, status = ?, error = ?,
+			updated = ?, unchanged = ?, unknown = ?, failed = ?, withdrawn = ?, held = ?, finished_at = ?
+		WHERE id = ?
+	`, run.FeedHash, run.Status, run.Error, run.Updated, run.Unchanged, run.Unknown, run.Failed, run.Withdrawn,
+		run.Held, run.FinishedAt, run.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update import run %d: %w", run.Id, err)
+	}
+
+	for start := 0; start < len(changes); start += importRunInsertBatch {
+		batch := changes[start:min(start+importRunInsertBatch, len(changes))]
+		values := make([]string, len(batch))
+		args := make([]interface{}, 0, len(batch)*13)
+		for i, change := range batch {
+			values[i] = "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+			args = append(args, run.Id, change.ProductId, change.SKU, change.StoreId, change.PharmacyId,
+				change.OldPrice, change.NewPrice, change.OldCount, change.NewCount, change.OldHidden, change.NewHidden,
+				change.OldExpiration, change.NewExpiration)
+		}
+		_, err = tx.Exec(`
+			INSERT INTO supplier_import_changes
+				(run_id, product_id, sku, store_id, pharmacy_id, old_price, new_price, old_count, new_count, old_hidden, new_hidden,
+					old_expiration, new_expiration)
+			VALUES `+strings.Join(values, ", "), args...)
+		if err != nil {
+			return fmt.Errorf("failed to insert changes of import run %d: %w", run.Id, err)
+		}
+	}
+
+	for start := 0; start < len(failures); start += importRunInsertBatch {
+		batch := failures[start:min(start+importRunInsertBatch, len(failures))]
+		values := make([]string, len(batch))
+		args := make([]interface{}, 0, len(batch)*6)
+		for i, failure := range batch {
+			values[i] = "(?, ?, ?, ?, ?, ?)"
+			args = append(args, run.Id, failure.SKU, failure.StoreId, failure.PharmacyId, failure.Line, failure.Error)
+		}
+		_, err = tx.Exec(`
+			INSERT INTO supplier_import_failures (run_id, sku, store_id, pharmacy_id, line, error)
+			VALUES `+strings.Join(values, ", "), args...)
+		if err != nil {
+			return fmt.Errorf("failed to insert failures of import run %d: %w", run.Id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Runs возвращает запуски поставщика от новых к старым с пагинацией.
+// Пустой supplier означает всех поставщиков.
+func (r *importRunRepository) Runs(supplier string, limit, offset int) ([]models.ImportRun, error) {
+	query := `SELECT * FROM supplier_import_runs`
+	var args []interface{}
+	if supplier != "" {
+		query += ` WHERE supplier = ?`
+		args = append(args, supplier)
+	}
+	query += ` ORDER BY id DESC LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	var runs []models.ImportRun
+	if err := r.db.Select(&runs, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to fetch import runs: %w", err)
+	}
+	return runs, nil
+}
+
+// Get возвращает запуск с изменениями и ошибками строк, при непустом sku только по нему.
+func (r *importRunRepository) Get(id int, sku string) (models.ImportRun, error) {
+	var run models.ImportRun
+	err := r.db.Get(&run, `SELECT * FROM supplier_import_runs WHERE id = ?`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ImportRun{}, ErrNotFound
+		}
+		return models.ImportRun{}, fmt.Errorf("failed to fetch import run %d: %w", id, err)
+	}
+
+	filter := ""
+	args := []interface{}{id}
+	if sku != "" {
+		filter = " AND sku = ?"
+		args = append(args, sku)
+	}
+
+	err = r.db.Select(&run.Changes, `
+		SELECT product_id, sku, store_id, pharmacy_id, old_price, new_price, old_count, new_count, old_hidden, new_hidden,
+			old_expiration, new_expiration
+		FROM supplier_import_changes
+		WHERE run_id = ?`+filter+`
+		ORDER BY id
+	`, args...)
+	if err != nil {
+		return models.ImportRun{}, fmt.Errorf("failed to fetch changes of import run %d: %w", id, err)
+	}
+	err = r.db.Select(&run.Failures, `
+		SELECT sku, store_id, pharmacy_id, line, error
+		FROM supplier_import_failures
+		WHERE run_id = ?`+filter+`
+		ORDER BY id
+	`, args...)
+	if err != nil {
+		return models.ImportRun{}, fmt.Errorf("failed to fetch failures of import run %d: %w", id, err)
+	}
+	return run, nil
+}
+
+// MarkRolledBack помечает запуск откатанным запуском rollbackId. Если запуск уже
+// откатан, возвращает ErrImportRunRolledBack.
+func (r *importRunRepository) MarkRolledBack(id, rollbackId int) error {
+	result, err := r.db.Exec(`
+		UPDATE supplier_import_runs SET rolled_back_by = ?
+		WHERE id = ? AND rolled_back_by IS NULL
+	`, rollbackId, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark import run %d rolled back: %w", id, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to mark import run %d rolled back: %w", id, err)
+	}
+	if affected == 0 {
+		return ErrImportRunRolledBack
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package repositories
+
+import (
+	"aurma_product/internal/models"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// ErrMatchResolved возвращается при попытке повторно принять или отклонить запись очереди.
+var ErrMatchResolved = errors.New("match already resolved")
+
+type matchRepository struct {
+	db *sqlx.DB
+}
+
+// NewMatchRepository создает новый экземпляр MatchRepository.
+func NewMatchRepository(db *sqlx.DB) MatchRepository {
+	return &matchRepository{db: db}
+}
+
+// Enqueue добавляет или обновляет запись очереди и заменяет ее кандидатов.
+// Уже принятые или отклоненные записи не меняются.
+func (r *matchRepository) Enqueue(match models.SupplierMatch) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var existing models.SupplierMatch
+	err = tx.Get(&existing, `
+		SELECT id, status FROM supplier_matches
+		WHERE supplier = ? AND sku = ? AND pharmacy_id = ?
+		FOR UPDATE
+	`, match.Supplier, match.Sku, match.PharmacyId)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		result, err := tx.Exec(`
+			INSERT INTO supplier_matches (supplier, sku, store_id, pharmacy_id, title, brand, barcodes, price, count, status)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, match.Supplier, match.Sku, match.StoreId, match.PharmacyId, match.Title, match.Brand, match.Barcodes,
+			match.Price, match.Count, models.MatchStatusPending)
+		if err != nil {
+			return fmt.Errorf("failed to insert match for SKU %s: %w", match.Sku, err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get match id: %w", err)
+		}
+		existing.Id = int(id)
+	case err != nil:
+		return fmt.Errorf("failed to fetch match for SKU %s: %w", match.Sku, err)
+	case existing.Status != models.MatchStatusPending:
+		return nil
+	default:
+		_, err = tx.Exec(`
+			UPDATE supplier_matches SET store_id = ?, title = ?, brand = ?, barcodes = ?, price = ?, count = ?, updated_at = ?
+			WHERE id = ?
+		`, match.StoreId, match.Title, match.Brand, match.Barcodes, match.Price, match.Count, time.Now(), existing.Id)
+		if err != nil {
+			return fmt.Errorf("failed to update match %d: %w", existing.Id, err)
+		}
+		if _, err = tx.Exec(`DELETE FROM supplier_match_candidates WHERE match_id = ?`, existing.Id); err != nil {
+			return fmt.Errorf("failed to clear candidates of match %d: %w", existing.Id, err)
+		}
+	}
+
+	for _, candidate := range match.Candidates {
+		_, err = tx.Exec(`
+			INSERT INTO supplier_match_candidates (match_id, product_id, title, source, confidence)
+			VALUES (?, ?, ?, ?, ?)
+		`, existing.Id, candidate.ProductId, candidate.Title, candidate.Source, candidate.Confidence)
+		if err != nil {
+			return fmt.Errorf("failed to insert candidate for match %d: %w", existing.Id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Matches возвращает записи очереди с кандидатами по статусу и поставщику с пагинацией.
+// Пустой supplier означает всех поставщиков.
+func (r *matchRepository) Matches(supplier, status string, limit, offset int) ([]models.SupplierMatch, error) {
+	query := `SELECT * FROM supplier_matches WHERE status = ?`
+	args := []interface{}{status}
+	if supplier != "" {
+		query += ` AND supplier = ?`
+		args = append(args, supplier)
+	}
+	query += ` ORDER BY id LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	var matches []models.SupplierMatch
+	if err := r.db.Select(&matches, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to fetch matches: %w", err)
+	}
+	if err := r.withCandidates(matches); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// Get возвращает запись очереди с кандидатами.
+func (r *matchRepository) Get(id int) (models.SupplierMatch, error) {
+	var match models.SupplierMatch
+	err := r.db.Get(&match, `SELECT * FROM supplier_matches WHERE id = ?`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.SupplierMatch{}, ErrNotFound
+		}
+		return models.SupplierMatch{}, fmt.Errorf("failed to fetch match %d: %w", id, err)
+	}
+
+	matches := []models.SupplierMatch{match}
+	if err := r.withCandidates(matches); err != nil {
+		return models.SupplierMatch{}, err
+	}
+	return matches[0], nil
+}
+
+func (r *matchRepository) withCandidates(matches []models.SupplierMatch) error {
+	if len(matches) == 0 {
+		return nil
+	}
+	ids := make([]int, len(matches))
+	index := make(map[int]int, len(matches))
+	for i, match := range matches {
+		ids[i] = match.Id
+		index[match.Id] = i
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT * FROM supplier_match_candidates
+		WHERE match_id IN (?)
+		ORDER BY confidence DESC, id
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to build candidates query: %w", err)
+	}
+	var candidates []models.MatchCandidate
+	if err := r.db.Select(&candidates, r.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("failed to fetch candidates: %w", err)
+	}
+	for _, candidate := range candidates {
+		i := index[candidate.MatchId]
+		matches[i].Candidates = append(matches[i].Candidates, candidate)
+	}
+	return nil
+}
+
+// Accept связывает SKU записи с продуктом: создает строки product_pharmacy с ценой и
+// остатком из фида для всех ожидающих записей поставщика с этим SKU, по одной на аптеку,
+// и помечает их принятыми.
+func (r *matchRepository) Accept(id, productId int) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	match, err := r.lockPending(tx, id)
+	if err != nil {
+		return err
+	}
+
+	var pending []models.SupplierMatch
+	err = tx.Select(&pending, `
+		SELECT * FROM supplier_matches
+		WHERE supplier = ? AND sku = ? AND status = ?
+		FOR UPDATE
+	`, match.Supplier, match.Sku, models.MatchStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to fetch pending matches for SKU %s: %w", match.Sku, err)
+	}
+
+	for _, m := range pending {
+		var exists bool
+		err = tx.Get(&exists, `SELECT EXISTS(SELECT 1 FROM product_pharmacy WHERE sku = ? AND pharmacy_id = ?)`, m.Sku, m.PharmacyId)
+		if err != nil {
+			return fmt.Errorf("failed to check product pharmacy: %w", err)
+		}
+		if exists {
+			continue
+		}
+		_, err = tx.Exec(`
+			INSERT INTO product_pharmacy (product_id, sku, pharmacy_id, price, count, updated_at, supplier)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, productId, m.Sku, m.PharmacyId, m.Price, m.Count, time.Now(), m.Supplier)
+		if err != nil {
+			return fmt.Errorf("failed to create product pharmacy for SKU %s in pharmacy %d: %w", m.Sku, m.PharmacyId, err)
+		}
+	}
+
+	_, err = tx.Exec(`
+		UPDATE supplier_matches SET status = ?, product_id = ?, updated_at = ?
+		WHERE supplier = ? AND sku = ? AND status = ?
+	`, models.MatchStatusAccepted, productId, time.Now(), match.Supplier, match.Sku, models.MatchStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to accept match %d: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Resolved возвращает принятые и отклоненные записи поставщика с указанными SKU.
+func (r *matchRepository) Resolved(supplier string, skus []string) ([]models.SupplierMatch, error) {
+	if len(skus) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT id, supplier, sku, pharmacy_id, status, product_id
+		FROM supplier_matches
+		WHERE supplier = ? AND sku IN (?) AND status <> ?
+	`, supplier, skus, models.MatchStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build resolved matches query: %w", err)
+	}
+
+	var matches []models.SupplierMatch
+	if err := r.db.Select(&matches, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("failed to fetch resolved matches: %w", err)
+	}
+	return matches, nil
+}
+
+// Reject помечает запись отклоненной, повторно в очередь она не попадет.
+func (r *matchRepository) Reject(id int) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := r.lockPending(tx, id); err != nil {
+		return err
+	}
+	_, err = tx.Exec(`UPDATE supplier_matches SET status = ?, updated_at = ? WHERE id = ?`,
+		models.MatchStatusRejected, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to reject match %d: %w", id, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *matchRepository) lockPending(tx *sqlx.Tx, id int) (models.SupplierMatch, error) {
+	var match models.SupplierMatch
+	err := tx.Get(&match, `SELECT * FROM supplier_matches WHERE id = ? FOR UPDATE`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.SupplierMatch{}, ErrNotFound
+		}
+		return models.SupplierMatch{}, fmt.Errorf("failed to fetch match %d: %w", id, err)
+	}
+	if match.Status != models.MatchStatusPending {
+		return models.SupplierMatch{}, ErrMatchResolved
+	}
+	return match, nil
+}
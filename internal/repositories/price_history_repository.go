@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"aurma_product/internal/models"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"time"
+)
+
+// priceHistoryBatch сколько пар продукт-аптека сверяется и вставляется одним запросом.
+const priceHistoryBatch = 500
+
+type priceHistoryKey struct {
+	productId  int
+	pharmacyId int
+}
+
+type priceHistoryRepository struct {
+	db *sqlx.DB
+}
+
+// NewPriceHistoryRepository создает новый экземпляр PriceHistoryRepository.
+func NewPriceHistoryRepository(db *sqlx.DB) PriceHistoryRepository {
+	return &priceHistoryRepository{db: db}
+}
+
+// Record записывает точки, которые отличаются от последней точки своей пары
+// продукт-аптека, поэтому повторная запись той же цены ничего не добавляет.
+// Из нескольких точек одной пары в пачке сверяется последняя.
+func (r *priceHistoryRepository) Record(points []models.PricePoint) error {
+	for start := 0; start < len(points); start += priceHistoryBatch {
+		if err := r.record(points[start:min(start+priceHistoryBatch, len(points))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *priceHistoryRepository) record(points []models.PricePoint) error {
+	latest := make(map[priceHistoryKey]models.PricePoint, len(points))
+	keys := make([]string, 0, len(points))
+	keyArgs := make([]interface{}, 0, len(points)*2)
+	for _, point := range points {
+		key := priceHistoryKey{point.ProductId, point.PharmacyId}
+		if _, ok := latest[key]; !ok {
+			keys = append(keys, "(?, ?)")
+			keyArgs = append(keyArgs, point.ProductId, point.PharmacyId)
+		}
+		latest[key] = point
+	}
+
+	var last []models.PricePoint
+	err := r.db.Select(&last, `
+		SELECT history.product_id, history.pharmacy_id, history.price, history.count, history.recorded_at
+		FROM product_price_history history
+		JOIN (
+			SELECT MAX(id) AS id FROM product_price_history
+			WHERE (product_id, pharmacy_id) IN (`+strings.Join(keys, ", ")+`)
+			GROUP BY product_id, pharmacy_id
+		) last ON last.id = history.id
+	`, keyArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to fetch last price points: %w", err)
+	}
+	for _, point := range last {
+		key := priceHistoryKey{point.ProductId, point.PharmacyId}
+		if current := latest[key]; current.Price == point.Price && current.Count == point.Count {
+			delete(latest, key)
+		}
+	}
+	if len(latest) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(latest))
+	args := make([]interface{}, 0, len(latest)*5)
+	for _, point := range latest {
+		if point.RecordedAt.IsZero() {
+			point.RecordedAt = time.Now()
+		}
+		values = append(values, "(?, ?, ?, ?, ?)")
+		args = append(args, point.ProductId, point.PharmacyId, point.Price, point.Count, point.RecordedAt)
+	}
+	_, err = r.db.Exec(`
+		INSERT INTO product_price_history (product_id, pharmacy_id, price, count, recorded_at)
+		VALUES `+strings.Join(values, ", "), args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert price points: %w", err)
+	}
+	return nil
+}
+
+// Points возвращает точки продукта за период [from, to) вместе с последней точкой
+// каждой аптеки до его начала, отсортированные по времени.
+func (r *priceHistoryRepository) Points(productId, pharmacyId int, from, to time.Time) ([]models.PricePoint, error) {
+	filter := "product_id = ?"
+	args := []interface{}{productId}
+	if pharmacyId != 0 {
+		filter += " AND pharmacy_id = ?"
+		args = append(args, pharmacyId)
+	}
+
+	queryArgs := append([]interface{}{}, args...)
+	queryArgs = append(queryArgs, from)
+	queryArgs = append(queryArgs, args...)
+	queryArgs = append(queryArgs, from, to)
+
+	var points []models.PricePoint
+	err := r.db.Select(&points, `
+		SELECT history.product_id, history.pharmacy_id, history.price, history.count, history.recorded_at
+		FROM product_price_history history
+		JOIN (
+			SELECT MAX(id) AS id FROM product_price_history
+			WHERE `+filter+` AND recorded_at < ?
+			GROUP BY pharmacy_id
+		) last ON last.id = history.id
+		UNION ALL
+		SELECT product_id, pharmacy_id, price, count, recorded_at
+		FROM product_price_history
+		WHERE `+filter+` AND recorded_at >= ? AND recorded_at < ?
+		ORDER BY recorded_at, pharmacy_id
+	`, queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch price history of product %d: %w", productId, err)
+	}
+	return points, nil
+}
+
+// Prune удаляет точки старше before. Последняя такая точка каждой пары остается:
+// это цена, действующая на начало хранимой истории.
+func (r *priceHistoryRepository) Prune(before time.Time) (int64, error) {
+	result, err := r.db.Exec(`
+		DELETE history FROM product_price_history history
+		JOIN (
+			SELECT product_id, pharmacy_id, MAX(id) AS id FROM product_price_history
+			WHERE recorded_at < ?
+			GROUP BY product_id, pharmacy_id
+		) keep ON keep.product_id = history.product_id AND keep.pharmacy_id = history.pharmacy_id
+		WHERE history.id < keep.id
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune price history: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune price history: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,216 @@
+package repositories
+
+import (
+	"aurma_product/internal/models"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+var (
+	// ErrHoldResolved возвращается при повторном решении по задержанному изменению.
+	ErrHoldResolved = errors.New("price hold already resolved")
+	// ErrHoldStale возвращается, когда строка product_pharmacy изменилась после задержки.
+	ErrHoldStale = errors.New("product pharmacy changed since the hold")
+)
+
+const priceHoldColumns = `id, run_id, supplier, product_id, sku, store_id, pharmacy_id,
+	old_price, new_price, old_count, new_count, old_hidden, old_expiration, new_expiration,
+	reason, status, created_at, resolved_at`
+
+type priceHoldRepository struct {
+	db *sqlx.DB
+}
+
+// NewPriceHoldRepository создает новый экземпляр PriceHoldRepository.
+func NewPriceHoldRepository(db *sqlx.DB) PriceHoldRepository {
+	return &priceHoldRepository{db: db}
+}
+
+// Hold сохраняет задержанные изменения. Ожидающее решения изменение той же строки
+// заменяется новым, чтобы администратор видел последнее значение из фида.
+func (r *priceHoldRepository) Hold(holds []models.PriceHold) error {
+	for _, hold := range holds {
+		if err := r.hold(hold); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *priceHoldRepository) hold(hold models.PriceHold) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+	err = tx.Get(&id, `
+		SELECT id FROM supplier_price_holds
+		WHERE supplier = ? AND sku = ? AND pharmacy_id = ? AND status = ?
+		FOR UPDATE
+	`, hold.Supplier, hold.SKU, hold.PharmacyId, models.PriceHoldPending)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		_, err = tx.Exec(`
+			INSERT INTO supplier_price_holds (run_id, supplier, product_id, sku, store_id, pharmacy_id,
+				old_price, new_price, old_count, new_count, old_hidden, old_expiration, new_expiration, reason, status)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, hold.RunId, hold.Supplier, hold.ProductId, hold.SKU, hold.StoreId, hold.PharmacyId,
+			hold.OldPrice, hold.NewPrice, hold.OldCount, hold.NewCount, hold.OldHidden, hold.OldExpiration, hold.NewExpiration,
+			hold.Reason, models.PriceHoldPending)
+		if err != nil {
+			return fmt.Errorf("failed to insert price hold for SKU %s: %w", hold.SKU, err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to fetch price hold for SKU %s: %w", hold.SKU, err)
+	default:
+		_, err = tx.Exec(`
+			UPDATE supplier_price_holds SET run_id = ?, product_id = ?, store_id = ?, old_price = ?, new_price = ?,
+				old_count = ?, new_count = ?, old_hidden = ?, old_expiration = ?, new_expiration = ?, reason = ?, created_at = ?
+			WHERE id = ?
+		`, hold.RunId, hold.ProductId, hold.StoreId, hold.OldPrice, hold.NewPrice, hold.OldCount, hold.NewCount,
+			hold.OldHidden, hold.OldExpiration, hold.NewExpiration, hold.Reason, time.Now(), id)
+		if err != nil {
+			return fmt.Errorf("failed to update price hold %d: %w", id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Holds возвращает задержанные изменения по статусу и поставщику с пагинацией.
+// Пустой supplier означает всех поставщиков.
+func (r *priceHoldRepository) Holds(supplier, status string, limit, offset int) ([]models.PriceHold, error) {
+	query := `SELECT ` + priceHoldColumns + ` FROM supplier_price_holds WHERE status = ?`
+	args := []interface{}{status}
+	if supplier != "" {
+		query += ` AND supplier = ?`
+		args = append(args, supplier)
+	}
+	query += ` ORDER BY id LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	var holds []models.PriceHold
+	if err := r.db.Select(&holds, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to fetch price holds: %w", err)
+	}
+	return holds, nil
+}
+
+// Get возвращает задержанное изменение.
+func (r *priceHoldRepository) Get(id int) (models.PriceHold, error) {
+	var hold models.PriceHold
+	err := r.db.Get(&hold, `SELECT `+priceHoldColumns+` FROM supplier_price_holds WHERE id = ?`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.PriceHold{}, ErrNotFound
+		}
+		return models.PriceHold{}, fmt.Errorf("failed to fetch price hold %d: %w", id, err)
+	}
+	return hold, nil
+}
+
+// Approve записывает задержанные цену, остаток и срок годности в product_pharmacy и возвращает
+// сделанное изменение. Если цена, остаток, видимость или срок строки изменились после задержки,
+// возвращает ErrHoldStale: решение принималось по другим данным.
+func (r *priceHoldRepository) Approve(id int) (models.ImportChange, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return models.ImportChange{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	hold, err := r.lockPending(tx, id)
+	if err != nil {
+		return models.ImportChange{}, err
+	}
+
+	var productPharmacy models.ProductPharmacy
+	err = tx.Get(&productPharmacy, `
+		SELECT product_id, sku, pharmacy_id, price, count, is_hidden, expiration_date
+		FROM product_pharmacy
+		WHERE sku = ? AND pharmacy_id = ?
+		FOR UPDATE
+	`, hold.SKU, hold.PharmacyId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ImportChange{}, ErrHoldStale
+		}
+		return models.ImportChange{}, fmt.Errorf("failed to fetch product pharmacy for SKU %s: %w", hold.SKU, err)
+	}
+	if productPharmacy.Price != hold.OldPrice || productPharmacy.Count != hold.OldCount ||
+		productPharmacy.IsHidden != hold.OldHidden ||
+		(hold.ExpirationTracked() && !models.SameDate(productPharmacy.ExpirationDate, hold.OldExpiration)) {
+		return models.ImportChange{}, ErrHoldStale
+	}
+
+	_, err = tx.Exec(`
+		UPDATE product_pharmacy SET price = ?, count = ?, expiration_date = COALESCE(?, expiration_date), is_hidden = 0, updated_at = ?
+		WHERE sku = ? AND pharmacy_id = ?
+	`, hold.NewPrice, hold.NewCount, hold.NewExpiration, time.Now(), hold.SKU, hold.PharmacyId)
+	if err != nil {
+		return models.ImportChange{}, fmt.Errorf("failed to update product pharmacy for SKU %s: %w", hold.SKU, err)
+	}
+	if err := r.resolve(tx, id, models.PriceHoldApproved); err != nil {
+		return models.ImportChange{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.ImportChange{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	change := hold.ImportChange
+	change.ProductId = productPharmacy.ProductId
+	change.NewHidden = false
+	return change, nil
+}
+
+// Reject отклоняет задержанное изменение, product_pharmacy не меняется.
+func (r *priceHoldRepository) Reject(id int) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := r.lockPending(tx, id); err != nil {
+		return err
+	}
+	if err := r.resolve(tx, id, models.PriceHoldRejected); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *priceHoldRepository) resolve(tx *sqlx.Tx, id int, status string) error {
+	_, err := tx.Exec(`UPDATE supplier_price_holds SET status = ?, resolved_at = ? WHERE id = ?`, status, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to resolve price hold %d: %w", id, err)
+	}
+	return nil
+}
+
+func (r *priceHoldRepository) lockPending(tx *sqlx.Tx, id int) (models.PriceHold, error) {
+	var hold models.PriceHold
+	err := tx.Get(&hold, `SELECT `+priceHoldColumns+` FROM supplier_price_holds WHERE id = ? FOR UPDATE`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.PriceHold{}, ErrNotFound
+		}
+		return models.PriceHold{}, fmt.Errorf("failed to fetch price hold %d: %w", id, err)
+	}
+	if hold.Status != models.PriceHoldPending {
+		return models.PriceHold{}, ErrHoldResolved
+	}
+	return hold, nil
+}
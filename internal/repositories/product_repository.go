@@ -38,8 +38,8 @@ func (r *productRepository) AllProductSearchData(offset, limit int) ([]models.Pr
 			product.is_active,
 			producers.title as company_name,
 			GROUP_CONCAT(distinct barcode_products.barcode SEPARATOR ", ") AS barcode,
-			GROUP_CONCAT(distinct inns.title SEPARATOR ", ") as mnn,
-			GROUP_CONCAT(distinct product_form.title SEPARATOR ", ") as issue_form
+			GROUP_CONCAT(distinct inns.title ORDER BY inns.title SEPARATOR ", ") as mnn,
+			GROUP_CONCAT(distinct product_form.title ORDER BY product_form.title SEPARATOR ", ") as issue_form
 			FROM product
 			LEFT JOIN barcode_products ON barcode_products.product_id = product.id
 			LEFT JOIN producers ON producers.id = product.producer_id
@@ -67,7 +67,7 @@ func (r *productRepository) GetById(id int) (models.Product, error) {
 	err := r.db.Get(&product, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return models.Product{}, fmt.Errorf("product with id %d not found", id)
+			return models.Product{}, fmt.Errorf("%w: product with id %d", ErrNotFound, id)
 		}
 		return models.Product{}, fmt.Errorf("failed to fetch product: %w", err)
 	}
@@ -82,8 +82,8 @@ func (r *productRepository) GetByIdSearchData(id int) (models.ProductSearchWithD
 			product.is_active,
 			producers.title as company_name,
 			GROUP_CONCAT(distinct barcode_products.barcode SEPARATOR ", ") AS barcode,
-			GROUP_CONCAT(distinct inns.title SEPARATOR ", ") as mnn,
-			GROUP_CONCAT(distinct product_form.title SEPARATOR ", ") as issue_form
+			GROUP_CONCAT(distinct inns.title ORDER BY inns.title SEPARATOR ", ") as mnn,
+			GROUP_CONCAT(distinct product_form.title ORDER BY product_form.title SEPARATOR ", ") as issue_form
 			FROM product
 			LEFT JOIN barcode_products ON barcode_products.product_id = product.id
 			LEFT JOIN producers ON producers.id = product.producer_id
@@ -98,7 +98,40 @@ func (r *productRepository) GetByIdSearchData(id int) (models.ProductSearchWithD
 	err := r.db.Get(&product, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return models.ProductSearchWithData{}, fmt.Errorf("product with id %d not found", id)
+			return models.ProductSearchWithData{}, fmt.Errorf("%w: product with id %d", ErrNotFound, id)
+		}
+		return models.ProductSearchWithData{}, fmt.Errorf("failed to fetch product: %w", err)
+	}
+	return product, nil
+}
+
+// GetBySlugSearchData возвращает продукт с данными для поиска по его slug.
+func (r *productRepository) GetBySlugSearchData(slug string) (models.ProductSearchWithData, error) {
+	query := `
+			SELECT 
+			product.id,
+			product.title,
+			product.slug,
+			product.is_active,
+			producers.title as company_name,
+			GROUP_CONCAT(distinct barcode_products.barcode SEPARATOR ", ") AS barcode,
+			GROUP_CONCAT(distinct inns.title ORDER BY inns.title SEPARATOR ", ") as mnn,
+			GROUP_CONCAT(distinct product_form.title ORDER BY product_form.title SEPARATOR ", ") as issue_form
+			FROM product
+			LEFT JOIN barcode_products ON barcode_products.product_id = product.id
+			LEFT JOIN producers ON producers.id = product.producer_id
+			LEFT JOIN product_inns ON product_inns.product_id = product.id
+			LEFT JOIN inns ON inns.id = product_inns.inn_id
+			LEFT JOIN product_product_forms  ON product_product_forms.product_id = product.id
+			LEFT JOIN product_form ON product_form.id = product_product_forms.form_id
+			where product.slug = ?
+			GROUP BY product.id
+`
+	var product models.ProductSearchWithData
+	err := r.db.Get(&product, query, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ProductSearchWithData{}, fmt.Errorf("%w: product with slug %s", ErrNotFound, slug)
 		}
 		return models.ProductSearchWithData{}, fmt.Errorf("failed to fetch product: %w", err)
 	}
@@ -108,7 +141,7 @@ func (r *productRepository) GetByIdSearchData(id int) (models.ProductSearchWithD
 // ProductPharmaciesUpdated возвращает список обновленных аптек с продуктами.
 func (r *productRepository) ProductPharmaciesUpdated() ([]models.ProductPharmacy, error) {
 	//query := `SELECT product_id, pharmacy_id, price, count FROM product_pharmacy WHERE updated_at = CURDATE()`
-	query := `SELECT product_id, pharmacy_id, price, count FROM product_pharmacy`
+	query := `SELECT product_id, pharmacy_id, price, count, is_hidden, expiration_date FROM product_pharmacy`
 	var productPharmacies []models.ProductPharmacy
 	err := r.db.Select(&productPharmacies, query)
 	if err != nil {
@@ -153,7 +186,7 @@ func (r *productRepository) ProductFormName(productId int) (string, error) {
 
 // ProductPharmacies возвращает список аптек с продуктами с пагинацией.
 func (r *productRepository) ProductPharmacies(limit, offset int) ([]models.ProductPharmacy, error) {
-	query := `SELECT product_id, pharmacy_id, price, count FROM product_pharmacy LIMIT ? OFFSET ?`
+	query := `SELECT product_id, pharmacy_id, price, count, is_hidden FROM product_pharmacy LIMIT ? OFFSET ?`
 	var productPharmacies []models.ProductPharmacy
 	err := r.db.Select(&productPharmacies, query, limit, offset)
 	if err != nil {
@@ -167,7 +200,7 @@ func (r *productRepository) ProductPharmacy(productId int) (models.ProductPharma
 	query := `
 		SELECT product_id, pharmacy_id, price, count 
 		FROM product_pharmacy
-		WHERE product_id = ?
+		WHERE product_id = ? AND is_hidden = 0
 		ORDER BY price ASC 
 		LIMIT 1
 	`
@@ -175,12 +208,175 @@ func (r *productRepository) ProductPharmacy(productId int) (models.ProductPharma
 	err := r.db.Get(&productPharmacy, query, productId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return models.ProductPharmacy{}, fmt.Errorf("no pharmacy found for product with id %d", productId)
+			return models.ProductPharmacy{}, fmt.Errorf("%w: no pharmacy found for product with id %d", ErrNotFound, productId)
 		}
 		return models.ProductPharmacy{}, fmt.Errorf("failed to fetch product pharmacy: %w", err)
 	}
 	return productPharmacy, nil
 }
+
+// ProductPharmacyAggregates возвращает сводку цен и остатков по аптекам для продуктов.
+// Продукты без предложений в результат не попадают.
+func (r *productRepository) ProductPharmacyAggregates(productIds []int) (map[int]models.ProductPharmacyAggregate, error) {
+	result := make(map[int]models.ProductPharmacyAggregate, len(productIds))
+	if len(productIds) == 0 {
+		return result, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT
+			product_id,
+			COALESCE(MIN(CASE WHEN count > 0 AND price > 0 THEN price END), MIN(CASE WHEN price > 0 THEN price END), 0) AS min_price,
+			COALESCE(MAX(CASE WHEN count > 0 AND price > 0 THEN price END), MAX(CASE WHEN price > 0 THEN price END), 0) AS max_price,
+			COALESCE(SUM(CASE WHEN count > 0 THEN count END), 0) AS total_count,
+			COALESCE(SUM(count > 0), 0) AS pharmacies_in_stock
+		FROM product_pharmacy
+		WHERE product_id IN (?) AND is_hidden = 0
+		GROUP BY product_id
+	`, productIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build aggregates query: %w", err)
+	}
+
+	var aggregates []models.ProductPharmacyAggregate
+	err = r.db.Select(&aggregates, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product pharmacy aggregates: %w", err)
+	}
+	for _, aggregate := range aggregates {
+		result[aggregate.ProductId] = aggregate
+	}
+	return result, nil
+}
+
+// ProductPharmaciesByProducts возвращает предложения аптек с городом аптеки,
+// сгруппированные по продукту.
+func (r *productRepository) ProductPharmaciesByProducts(productIds []int) (map[int][]models.ProductPharmacy, error) {
+	result := make(map[int][]models.ProductPharmacy, len(productIds))
+	if len(productIds) == 0 {
+		return result, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT product_pharmacy.product_id, product_pharmacy.pharmacy_id, pharmacy.city_id,
+			product_pharmacy.price, product_pharmacy.count, product_pharmacy.updated_at, product_pharmacy.expiration_date
+		FROM product_pharmacy
+		LEFT JOIN pharmacy ON pharmacy.id = product_pharmacy.pharmacy_id
+		WHERE product_pharmacy.product_id IN (?) AND product_pharmacy.is_hidden = 0
+	`, productIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build product pharmacies query: %w", err)
+	}
+
+	var productPharmacies []models.ProductPharmacy
+	err = r.db.Select(&productPharmacies, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product pharmacies: %w", err)
+	}
+	for _, productPharmacy := range productPharmacies {
+		result[productPharmacy.ProductId] = append(result[productPharmacy.ProductId], productPharmacy)
+	}
+	return result, nil
+}
+
+// ProductIdsUpdatedSince возвращает продукты, сам продукт или предложения которых менялись с since.
+func (r *productRepository) ProductIdsUpdatedSince(since time.Time) ([]int, error) {
+	query := `
+		SELECT product_id FROM product_pharmacy WHERE updated_at >= ?
+		UNION
+		SELECT id FROM product WHERE updated_at >= ?
+	`
+	var productIds []int
+	err := r.db.Select(&productIds, query, since, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch products updated since %s: %w", since.Format(time.RFC3339), err)
+	}
+	return productIds, nil
+}
+
+// ProductPharmaciesBySku возвращает строки product_pharmacy с указанными SKU во всех аптеках.
+func (r *productRepository) ProductPharmaciesBySku(skus []string) ([]models.ProductPharmacy, error) {
+	if len(skus) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT product_id, sku, pharmacy_id, price, count, updated_at, is_hidden, expiration_date, supplier
+		FROM product_pharmacy
+		WHERE sku IN (?)
+	`, skus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build product pharmacies by sku query: %w", err)
+	}
+
+	var productPharmacies []models.ProductPharmacy
+	err = r.db.Select(&productPharmacies, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product pharmacies by sku: %w", err)
+	}
+	return productPharmacies, nil
+}
+
+// ProductIdsByBarcodes возвращает продукты по точному совпадению штрихкода.
+func (r *productRepository) ProductIdsByBarcodes(barcodes []string) ([]int, error) {
+	if len(barcodes) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT DISTINCT product_id FROM barcode_products WHERE barcode IN (?) ORDER BY product_id
+	`, barcodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build barcode query: %w", err)
+	}
+
+	var productIds []int
+	err = r.db.Select(&productIds, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch products by barcodes: %w", err)
+	}
+	return productIds, nil
+}
+
+// ProductPharmacyOffers возвращает все предложения аптек по продукту. Положительный
+// minShelfLifeDays убирает предложения, срок годности которых истекает раньше чем через
+// столько дней; предложения без срока годности остаются.
+func (r *productRepository) ProductPharmacyOffers(productId int, sort string, inStockOnly bool, minShelfLifeDays int) ([]models.ProductPharmacy, error) {
+	query := `
+		SELECT product_id, pharmacy_id, price, count, updated_at, expiration_date
+		FROM product_pharmacy
+		WHERE product_id = ? AND is_hidden = 0
+	`
+	args := []interface{}{productId}
+	if inStockOnly {
+		query += " AND count > 0"
+	}
+	if minShelfLifeDays > 0 {
+		query += " AND (expiration_date IS NULL OR expiration_date >= CURDATE() + INTERVAL ? DAY)"
+		args = append(args, minShelfLifeDays)
+	}
+
+	switch strings.ToUpper(sort) {
+	case "PRICE_DESC":
+		query += " ORDER BY price DESC, pharmacy_id"
+	case "PRICE_ASC", "DEFAULT", "":
+		query += " ORDER BY price ASC, pharmacy_id"
+	case "COUNT_DESC":
+		query += " ORDER BY count DESC, pharmacy_id"
+	case "COUNT_ASC":
+		query += " ORDER BY count ASC, pharmacy_id"
+	default:
+		return nil, fmt.Errorf("unknown sort option: %s", sort)
+	}
+
+	var offers []models.ProductPharmacy
+	err := r.db.Select(&offers, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch offers for product %d: %w", productId, err)
+	}
+	return offers, nil
+}
+
 func (r *productRepository) ProductPharmacyBySku(sku string) (models.ProductPharmacy, error) {
 	query := `
         SELECT product_id, pharmacy_id, price, count 
@@ -2,8 +2,13 @@ package repositories
 
 import (
 	"aurma_product/internal/models"
+	"errors"
+	"time"
 )
 
+// ErrNotFound возвращается, когда запрошенная запись отсутствует в базе.
+var ErrNotFound = errors.New("not found")
+
 type ProductRepository interface {
 	// GetById возвращает продукт по его ID.
 	GetById(id int) (models.Product, error)
@@ -14,6 +19,24 @@ type ProductRepository interface {
 	// ProductPharmacy возвращает информацию о продукте в аптеке с наименьшей ценой.
 	ProductPharmacy(productId int) (models.ProductPharmacy, error)
 
+	// ProductPharmacyAggregates возвращает сводку цен и остатков по аптекам для продуктов.
+	ProductPharmacyAggregates(productIds []int) (map[int]models.ProductPharmacyAggregate, error)
+
+	// ProductPharmaciesByProducts возвращает предложения аптек с городом аптеки, сгруппированные по продукту.
+	ProductPharmaciesByProducts(productIds []int) (map[int][]models.ProductPharmacy, error)
+
+	// ProductPharmacyOffers возвращает все предложения аптек по продукту, minShelfLifeDays
+	// убирает предложения с истекающим сроком годности.
+	ProductPharmacyOffers(productId int, sort string, inStockOnly bool, minShelfLifeDays int) ([]models.ProductPharmacy, error)
+
+	// ProductIdsUpdatedSince возвращает продукты, сам продукт или предложения которых менялись с since.
+	ProductIdsUpdatedSince(since time.Time) ([]int, error)
+	// ProductPharmaciesBySku возвращает строки product_pharmacy с указанными SKU во всех аптеках.
+	ProductPharmaciesBySku(skus []string) ([]models.ProductPharmacy, error)
+
+	// ProductIdsByBarcodes возвращает продукты по точному совпадению штрихкода.
+	ProductIdsByBarcodes(barcodes []string) ([]int, error)
+
 	// ProductPharmaciesUpdated возвращает список обновленных аптек с продуктами.
 	ProductPharmaciesUpdated() ([]models.ProductPharmacy, error)
 
@@ -28,5 +51,90 @@ type ProductRepository interface {
 
 	GetByIdSearchData(id int) (models.ProductSearchWithData, error)
 
+	// GetBySlugSearchData возвращает продукт с данными для поиска по его slug.
+	GetBySlugSearchData(slug string) (models.ProductSearchWithData, error)
+
 	AllProductSearchData(offset, limit int) ([]models.ProductSearchWithData, error)
 }
+
+type SupplierRepository interface {
+	// Stores возвращает склады поставщика с аптекой и ее городом, по идентификатору склада.
+	Stores(supplier string) (map[string]models.SupplierStore, error)
+
+	// SetStore привязывает склад поставщика к аптеке, заменяя прежнюю привязку.
+	SetStore(supplier, storeId string, pharmacyId int) error
+
+	// DeleteStore удаляет привязку склада поставщика.
+	DeleteStore(supplier, storeId string) error
+
+	// ProductPharmacies возвращает строки поставщика в аптеках, привязанных к его складам,
+	// при unclaimed вместе с еще не закрепленными ни за кем строками этих аптек.
+	ProductPharmacies(supplier string, unclaimed bool) ([]models.ProductPharmacy, error)
+}
+
+type MatchRepository interface {
+	// Enqueue добавляет или обновляет запись очереди сопоставления вместе с кандидатами.
+	Enqueue(match models.SupplierMatch) error
+
+	// Matches возвращает записи очереди с кандидатами по статусу и поставщику с пагинацией.
+	Matches(supplier, status string, limit, offset int) ([]models.SupplierMatch, error)
+
+	// Get возвращает запись очереди с кандидатами.
+	Get(id int) (models.SupplierMatch, error)
+
+	// Accept связывает SKU записи с продуктом через product_pharmacy во всех аптеках,
+	// где запись этого SKU ожидает решения.
+	Accept(id, productId int) error
+
+	// Resolved возвращает принятые и отклоненные записи поставщика с указанными SKU.
+	Resolved(supplier string, skus []string) ([]models.SupplierMatch, error)
+
+	// Reject отклоняет запись очереди.
+	Reject(id int) error
+}
+
+type ImportRunRepository interface {
+	// Start записывает начало запуска импорта и возвращает его идентификатор.
+	Start(run models.ImportRun) (int, error)
+
+	// Finish сохраняет итог запуска вместе с изменениями и ошибками строк.
+	Finish(run models.ImportRun, changes []models.ImportChange, failures []models.ImportFailure) error
+
+	// Runs возвращает запуски поставщика от новых к старым с пагинацией.
+	Runs(supplier string, limit, offset int) ([]models.ImportRun, error)
+
+	// Get возвращает запуск с изменениями и ошибками строк, при непустом sku только по нему.
+	Get(id int, sku string) (models.ImportRun, error)
+
+	// MarkRolledBack помечает запуск откатанным другим запуском.
+	MarkRolledBack(id, rollbackId int) error
+}
+
+type PriceHoldRepository interface {
+	// Hold сохраняет задержанные изменения. Ожидающее решения изменение той же строки заменяется.
+	Hold(holds []models.PriceHold) error
+
+	// Holds возвращает задержанные изменения по статусу и поставщику с пагинацией.
+	Holds(supplier, status string, limit, offset int) ([]models.PriceHold, error)
+
+	// Get возвращает задержанное изменение.
+	Get(id int) (models.PriceHold, error)
+
+	// Approve записывает задержанное изменение в product_pharmacy и возвращает сделанное изменение.
+	Approve(id int) (models.ImportChange, error)
+
+	// Reject отклоняет задержанное изменение.
+	Reject(id int) error
+}
+
+type PriceHistoryRepository interface {
+	// Record записывает точки, которые отличаются от последней точки своей пары продукт-аптека.
+	Record(points []models.PricePoint) error
+
+	// Points возвращает точки продукта за период вместе с последней точкой каждой аптеки до его начала.
+	// Нулевой pharmacyId означает все аптеки.
+	Points(productId, pharmacyId int, from, to time.Time) ([]models.PricePoint, error)
+
+	// Prune удаляет точки старше before, оставляя для каждой пары последнюю из них.
+	Prune(before time.Time) (int64, error)
+}
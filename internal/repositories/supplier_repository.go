@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"aurma_product/internal/models"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+)
+
+type supplierRepository struct {
+	db *sqlx.DB
+}
+
+// NewSupplierRepository создает новый экземпляр SupplierRepository.
+func NewSupplierRepository(db *sqlx.DB) SupplierRepository {
+	return &supplierRepository{db: db}
+}
+
+// Stores возвращает склады поставщика с аптекой и ее городом, по идентификатору склада.
+func (r *supplierRepository) Stores(supplier string) (map[string]models.SupplierStore, error) {
+	query := `
+		SELECT supplier_stores.store_id, supplier_stores.pharmacy_id, pharmacy.city_id
+		FROM supplier_stores
+		LEFT JOIN pharmacy ON pharmacy.id = supplier_stores.pharmacy_id
+		WHERE supplier_stores.supplier = ?
+	`
+	var stores []models.SupplierStore
+	err := r.db.Select(&stores, query, supplier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stores of supplier %s: %w", supplier, err)
+	}
+
+	result := make(map[string]models.SupplierStore, len(stores))
+	for _, store := range stores {
+		result[store.StoreId] = store
+	}
+	return result, nil
+}
+
+// SetStore привязывает склад поставщика к аптеке, заменяя прежнюю привязку.
+func (r *supplierRepository) SetStore(supplier, storeId string, pharmacyId int) error {
+	_, err := r.db.Exec(`
+		INSERT INTO supplier_stores (supplier, store_id, pharmacy_id) VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE pharmacy_id = VALUES(pharmacy_id)
+	`, supplier, storeId, pharmacyId)
+	if err != nil {
+		return fmt.Errorf("failed to save store %q of supplier %s: %w", storeId, supplier, err)
+	}
+	return nil
+}
+
+// DeleteStore удаляет привязку склада поставщика.
+func (r *supplierRepository) DeleteStore(supplier, storeId string) error {
+	res, err := r.db.Exec(`DELETE FROM supplier_stores WHERE supplier = ? AND store_id = ?`, supplier, storeId)
+	if err != nil {
+		return fmt.Errorf("failed to delete store %q of supplier %s: %w", storeId, supplier, err)
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return fmt.Errorf("%w: store %q of supplier %s", ErrNotFound, storeId, supplier)
+	}
+	return nil
+}
+
+// ProductPharmacies возвращает строки product_pharmacy, которые ведет фид поставщика,
+// в аптеках, привязанных к его складам. Строки других поставщиков в тех же аптеках не входят,
+// а строки, еще не закрепленные ни за одним поставщиком, входят при unclaimed.
+func (r *supplierRepository) ProductPharmacies(supplier string, unclaimed bool) ([]models.ProductPharmacy, error) {
+	owner := "product_pharmacy.supplier = ?"
+	if unclaimed {
+		owner = "(product_pharmacy.supplier = ? OR product_pharmacy.supplier IS NULL)"
+	}
+	query := fmt.Sprintf(`
+		SELECT product_pharmacy.product_id, product_pharmacy.sku, product_pharmacy.pharmacy_id,
+			product_pharmacy.price, product_pharmacy.count, product_pharmacy.updated_at, product_pharmacy.is_hidden,
+			product_pharmacy.expiration_date, product_pharmacy.supplier
+		FROM product_pharmacy
+		WHERE %s AND product_pharmacy.pharmacy_id IN (
+			SELECT pharmacy_id FROM supplier_stores WHERE supplier = ?
+		)
+	`, owner)
+	var productPharmacies []models.ProductPharmacy
+	err := r.db.Select(&productPharmacies, query, supplier, supplier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product pharmacies of supplier %s: %w", supplier, err)
+	}
+	return productPharmacies, nil
+}
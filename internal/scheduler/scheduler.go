@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"aurma_product/internal/di"
+	"context"
 	"github.com/robfig/cron/v3"
 	"log"
 )
@@ -32,10 +33,24 @@ func Run(container *di.Container) {
 			return
 		}
 		if len(products) > 0 {
-			if err := container.Elastic.ProductAddDocument(products); err != nil {
+			stats, err := container.Elastic.ProductAddDocument(products)
+			if err != nil {
 				log.Printf("Error: Error indexing updated product pharmacies: %v", err)
+				return
 			}
+			if stats.Failed > 0 {
+				log.Printf("Error: Failed to index %d of %d updated products: %+v", stats.Failed, len(products), stats.Errors)
+			}
+		}
+	})
+
+	c.AddFunc("@daily", func() {
+		deleted, err := container.PriceHistoryService.Prune(context.Background())
+		if err != nil {
+			log.Printf("Error: Error pruning price history: %v", err)
+			return
 		}
+		log.Printf("Info: Pruned %d price history points", deleted)
 	})
 
 	c.Start()
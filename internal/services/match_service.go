@@ -0,0 +1,262 @@
+package services
+
+import (
+	"aurma_product/internal/elastic"
+	"aurma_product/internal/models"
+	"aurma_product/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// matchCandidatesLimit сколько кандидатов хранится для одной записи очереди.
+	matchCandidatesLimit = 5
+	// matchMinConfidence ниже этой уверенности кандидаты из поиска отбрасываются.
+	matchMinConfidence = 0.3
+	// matchMaxSearchConfidence кандидат из поиска никогда не уверен так же, как совпавший штрихкод.
+	matchMaxSearchConfidence = 0.99
+)
+
+type matchService struct {
+	matchRepository   repositories.MatchRepository
+	productRepository repositories.ProductRepository
+	elastic           *elastic.Elastic
+}
+
+func NewMatchService(matchRepo repositories.MatchRepository, productRepo repositories.ProductRepository, es *elastic.Elastic) MatchService {
+	return &matchService{matchRepository: matchRepo, productRepository: productRepo, elastic: es}
+}
+
+// Enqueue подбирает кандидатов и ставит офферы с неизвестными SKU в очередь.
+// Записи, по которым уже принято решение, пропускаются до поиска кандидатов.
+// Кандидаты ищутся один раз на SKU, даже если он пришел для нескольких аптек.
+func (s *matchService) Enqueue(ctx context.Context, matches []models.SupplierMatch) error {
+	resolved, err := s.resolved(matches)
+	if err != nil {
+		return err
+	}
+
+	candidates := make(map[string][]models.MatchCandidate)
+	var errs []error
+	for _, match := range matches {
+		if resolved[matchKey{match.Supplier, match.Sku, match.PharmacyId}] {
+			continue
+		}
+		found, ok := candidates[match.Sku]
+		if !ok {
+			var err error
+			found, err = s.candidates(ctx, match)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("SKU %s: %w", match.Sku, err))
+			}
+			candidates[match.Sku] = found
+		}
+		match.Candidates = found
+		if err := s.matchRepository.Enqueue(match); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type matchKey struct {
+	supplier   string
+	sku        string
+	pharmacyId int
+}
+
+// matchResolvedBatch сколько SKU проверяется одним запросом.
+const matchResolvedBatch = 500
+
+// resolved возвращает записи очереди, по которым уже принято или отклонено решение.
+func (s *matchService) resolved(matches []models.SupplierMatch) (map[matchKey]bool, error) {
+	skus := make(map[string][]string)
+	seen := make(map[matchKey]bool)
+	for _, match := range matches {
+		key := matchKey{supplier: match.Supplier, sku: match.Sku}
+		if !seen[key] {
+			seen[key] = true
+			skus[match.Supplier] = append(skus[match.Supplier], match.Sku)
+		}
+	}
+
+	resolved := make(map[matchKey]bool)
+	for supplier, supplierSkus := range skus {
+		for start := 0; start < len(supplierSkus); start += matchResolvedBatch {
+			found, err := s.matchRepository.Resolved(supplier, supplierSkus[start:min(start+matchResolvedBatch, len(supplierSkus))])
+			if err != nil {
+				return nil, err
+			}
+			for _, match := range found {
+				resolved[matchKey{match.Supplier, match.Sku, match.PharmacyId}] = true
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// candidates ищет продукты сначала по штрихкодам оффера, затем поиском по названию и бренду.
+func (s *matchService) candidates(ctx context.Context, match models.SupplierMatch) ([]models.MatchCandidate, error) {
+	var candidates []models.MatchCandidate
+	seen := make(map[int]bool)
+
+	var barcodes []string
+	for _, barcode := range strings.Split(match.Barcodes, ",") {
+		if barcode = strings.TrimSpace(barcode); barcode != "" {
+			barcodes = append(barcodes, barcode)
+		}
+	}
+	productIds, err := s.productRepository.ProductIdsByBarcodes(barcodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, productId := range productIds {
+		product, err := s.productRepository.GetById(productId)
+		if err != nil {
+			return nil, err
+		}
+		seen[productId] = true
+		candidates = append(candidates, models.MatchCandidate{
+			ProductId:  productId,
+			Title:      product.Title,
+			Source:     models.MatchSourceBarcode,
+			Confidence: 1,
+		})
+	}
+
+	if match.Title != "" && len(candidates) < matchCandidatesLimit {
+		products, err := s.elastic.ProductMatch(ctx, match.Title, match.Brand, matchCandidatesLimit*2)
+		if err != nil {
+			return candidates, err
+		}
+		var found []models.MatchCandidate
+		for _, product := range products {
+			if seen[product.Id] {
+				continue
+			}
+			confidence := matchConfidence(match.Title, match.Brand, product.Title, product.CompanyName)
+			if confidence < matchMinConfidence {
+				continue
+			}
+			found = append(found, models.MatchCandidate{
+				ProductId:  product.Id,
+				Title:      product.Title,
+				Source:     models.MatchSourceSearch,
+				Confidence: confidence,
+			})
+		}
+		sort.SliceStable(found, func(i, j int) bool {
+			return found[i].Confidence > found[j].Confidence
+		})
+		candidates = append(candidates, found...)
+	}
+
+	if len(candidates) > matchCandidatesLimit {
+		candidates = candidates[:matchCandidatesLimit]
+	}
+	return candidates, nil
+}
+
+// matchConfidence оценивает совпадение по доле общих слов названия (коэффициент Дайса)
+// и совпадению бренда с производителем.
+func matchConfidence(title, brand, productTitle, companyName string) float64 {
+	confidence := diceCoefficient(words(title), words(productTitle))
+	if brand != "" {
+		brandScore := 0.0
+		brand, companyName = strings.ToLower(brand), strings.ToLower(companyName)
+		if companyName != "" && (strings.Contains(companyName, brand) || strings.Contains(brand, companyName)) {
+			brandScore = 1
+		}
+		confidence = confidence*0.8 + brandScore*0.2
+	}
+	confidence = math.Min(confidence, matchMaxSearchConfidence)
+	return math.Round(confidence*10000) / 10000
+}
+
+func words(text string) map[string]bool {
+	result := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		result[word] = true
+	}
+	return result
+}
+
+func diceCoefficient(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for word := range a {
+		if b[word] {
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(a)+len(b))
+}
+
+// Matches возвращает записи очереди по статусу, по умолчанию ожидающие решения.
+func (s *matchService) Matches(ctx context.Context, supplier, status string, limit, offset int) ([]models.SupplierMatch, error) {
+	if status == "" {
+		status = models.MatchStatusPending
+	}
+	return s.matchRepository.Matches(supplier, status, limit, offset)
+}
+
+// Accept принимает сопоставление и создает связи product_pharmacy во всех аптеках,
+// где SKU поставщика ждет решения. Без productId берется самый уверенный кандидат.
+func (s *matchService) Accept(ctx context.Context, id, productId int) (models.SupplierMatch, error) {
+	match, err := s.get(id)
+	if err != nil {
+		return models.SupplierMatch{}, err
+	}
+	if productId == 0 {
+		if len(match.Candidates) == 0 {
+			return models.SupplierMatch{}, ErrMatchNoCandidate
+		}
+		productId = match.Candidates[0].ProductId
+	}
+	if _, err := s.productRepository.GetById(productId); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.SupplierMatch{}, ErrProductNotFound
+		}
+		return models.SupplierMatch{}, err
+	}
+
+	if err := s.matchRepository.Accept(id, productId); err != nil {
+		return models.SupplierMatch{}, matchError(err)
+	}
+	return s.get(id)
+}
+
+// Reject отклоняет сопоставление, повторно SKU в очередь не попадет.
+func (s *matchService) Reject(ctx context.Context, id int) (models.SupplierMatch, error) {
+	if err := s.matchRepository.Reject(id); err != nil {
+		return models.SupplierMatch{}, matchError(err)
+	}
+	return s.get(id)
+}
+
+func (s *matchService) get(id int) (models.SupplierMatch, error) {
+	match, err := s.matchRepository.Get(id)
+	if err != nil {
+		return models.SupplierMatch{}, matchError(err)
+	}
+	return match, nil
+}
+
+func matchError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+		return ErrMatchNotFound
+	case errors.Is(err, repositories.ErrMatchResolved):
+		return ErrMatchResolved
+	}
+	return err
+}
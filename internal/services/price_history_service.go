@@ -0,0 +1,89 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"aurma_product/internal/repositories"
+	"context"
+	"math"
+	"time"
+)
+
+type priceHistoryService struct {
+	priceHistoryRepository repositories.PriceHistoryRepository
+	// retention сколько хранится история цен.
+	retention time.Duration
+}
+
+func NewPriceHistoryService(priceHistoryRepo repositories.PriceHistoryRepository, retentionDays int) PriceHistoryService {
+	if retentionDays <= 0 {
+		retentionDays = 365
+	}
+	return &priceHistoryService{
+		priceHistoryRepository: priceHistoryRepo,
+		retention:              time.Duration(retentionDays) * 24 * time.Hour,
+	}
+}
+
+// Trend возвращает точки изменения цены продукта за период [from, to) и дневные сводки.
+// Нулевой pharmacyId означает все аптеки.
+func (s *priceHistoryService) Trend(ctx context.Context, productId, pharmacyId int, from, to time.Time) (models.PriceTrend, error) {
+	points, err := s.priceHistoryRepository.Points(productId, pharmacyId, from, to)
+	if err != nil {
+		return models.PriceTrend{}, err
+	}
+	return models.PriceTrend{Points: points, Days: priceDays(points, from, to)}, nil
+}
+
+// Prune удаляет историю старше срока хранения и возвращает число удаленных точек.
+func (s *priceHistoryService) Prune(ctx context.Context) (int64, error) {
+	return s.priceHistoryRepository.Prune(time.Now().Add(-s.retention))
+}
+
+// priceDays считает по дням периода минимальную, среднюю и максимальную цену среди
+// цен, действовавших в этот день в аптеках с остатком: цены на начало дня и все
+// цены, установленные в течение дня. Дни без таких цен пропускаются.
+// points должны быть отсортированы по времени.
+func priceDays(points []models.PricePoint, from, to time.Time) []models.PriceDay {
+	var days []models.PriceDay
+	current := make(map[int]models.PricePoint)
+	next := 0
+	for day := startOfDay(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for ; next < len(points) && points[next].RecordedAt.Before(day); next++ {
+			current[points[next].PharmacyId] = points[next]
+		}
+
+		var prices []int
+		add := func(point models.PricePoint) {
+			if point.Count > 0 && point.Price > 0 {
+				prices = append(prices, point.Price)
+			}
+		}
+		for _, point := range current {
+			add(point)
+		}
+		end := day.AddDate(0, 0, 1)
+		for ; next < len(points) && points[next].RecordedAt.Before(end); next++ {
+			current[points[next].PharmacyId] = points[next]
+			add(points[next])
+		}
+		if len(prices) == 0 {
+			continue
+		}
+
+		summary := models.PriceDay{Day: day, MinPrice: prices[0], MaxPrice: prices[0]}
+		sum := 0
+		for _, price := range prices {
+			summary.MinPrice = min(summary.MinPrice, price)
+			summary.MaxPrice = max(summary.MaxPrice, price)
+			sum += price
+		}
+		summary.AvgPrice = math.Round(float64(sum)/float64(len(prices))*100) / 100
+		days = append(days, summary)
+	}
+	return days
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPriceDays(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, 3, day, hour, 0, 0, 0, time.UTC)
+	}
+	point := func(pharmacyId, price, count int, recordedAt time.Time) models.PricePoint {
+		return models.PricePoint{ProductId: 1, PharmacyId: pharmacyId, Price: price, Count: count, RecordedAt: recordedAt}
+	}
+	day := func(d, minPrice int, avgPrice float64, maxPrice int) models.PriceDay {
+		return models.PriceDay{Day: at(d, 0), MinPrice: minPrice, AvgPrice: avgPrice, MaxPrice: maxPrice}
+	}
+
+	tests := []struct {
+		name     string
+		points   []models.PricePoint
+		from, to time.Time
+		want     []models.PriceDay
+	}{
+		{
+			name: "prices carried over and changed during days",
+			points: []models.PricePoint{
+				point(1, 100, 5, time.Date(2025, 2, 28, 12, 0, 0, 0, time.UTC)),
+				point(2, 200, 0, time.Date(2025, 2, 28, 12, 0, 0, 0, time.UTC)),
+				point(1, 120, 5, at(1, 10)),
+				point(2, 210, 3, at(2, 15)),
+				point(1, 120, 0, at(3, 9)),
+			},
+			from: at(1, 0),
+			to:   at(5, 0),
+			want: []models.PriceDay{
+				day(1, 100, 110, 120),
+				day(2, 120, 165, 210),
+				day(3, 120, 165, 210),
+				day(4, 210, 210, 210),
+			},
+		},
+		{
+			name: "days without stock are skipped",
+			points: []models.PricePoint{
+				point(1, 100, 0, at(1, 8)),
+				point(1, 90, 4, at(3, 8)),
+			},
+			from: at(1, 0),
+			to:   at(4, 0),
+			want: []models.PriceDay{day(3, 90, 90, 90)},
+		},
+		{
+			name: "zero prices are ignored",
+			points: []models.PricePoint{
+				point(1, 0, 5, at(1, 8)),
+				point(2, 150, 5, at(1, 9)),
+			},
+			from: at(1, 0),
+			to:   at(2, 0),
+			want: []models.PriceDay{day(1, 150, 150, 150)},
+		},
+		{
+			name: "average is rounded to two digits",
+			points: []models.PricePoint{
+				point(1, 100, 1, at(1, 8)),
+				point(2, 101, 1, at(1, 9)),
+				point(3, 101, 1, at(1, 10)),
+			},
+			from: at(1, 0),
+			to:   at(2, 0),
+			want: []models.PriceDay{day(1, 100, 100.67, 101)},
+		},
+		{
+			name: "period starting mid-day covers the whole day",
+			points: []models.PricePoint{
+				point(1, 100, 1, at(1, 8)),
+			},
+			from: at(1, 12),
+			to:   at(2, 0),
+			want: []models.PriceDay{day(1, 100, 100, 100)},
+		},
+		{
+			name: "no points",
+			from: at(1, 0),
+			to:   at(3, 0),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := priceDays(tt.points, tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("priceDays() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"aurma_product/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type priceHoldService struct {
+	priceHoldRepository    repositories.PriceHoldRepository
+	priceHistoryRepository repositories.PriceHistoryRepository
+	importRunRepository    repositories.ImportRunRepository
+	productService         ProductService
+}
+
+func NewPriceHoldService(priceHoldRepo repositories.PriceHoldRepository, priceHistoryRepo repositories.PriceHistoryRepository, importRunRepo repositories.ImportRunRepository, productService ProductService) PriceHoldService {
+	return &priceHoldService{
+		priceHoldRepository:    priceHoldRepo,
+		priceHistoryRepository: priceHistoryRepo,
+		importRunRepository:    importRunRepo,
+		productService:         productService,
+	}
+}
+
+// Holds возвращает задержанные изменения по статусу, по умолчанию ожидающие решения.
+func (s *priceHoldService) Holds(ctx context.Context, supplier, status string, limit, offset int) ([]models.PriceHold, error) {
+	if status == "" {
+		status = models.PriceHoldPending
+	}
+	return s.priceHoldRepository.Holds(supplier, status, limit, offset)
+}
+
+// Approve записывает задержанное изменение и переиндексирует продукт. Изменение
+// пишется в историю импортов запуском approve, его можно откатить как импорт.
+func (s *priceHoldService) Approve(ctx context.Context, id int) (models.PriceHold, error) {
+	change, err := s.priceHoldRepository.Approve(id)
+	if err != nil {
+		return models.PriceHold{}, holdError(err)
+	}
+	hold, err := s.get(id)
+	if err != nil {
+		return models.PriceHold{}, err
+	}
+
+	run := models.ImportRun{
+		Supplier:  hold.Supplier,
+		Source:    fmt.Sprintf("hold #%d", hold.Id),
+		Mode:      models.ImportModeApprove,
+		StartedAt: time.Now(),
+	}
+	if run.Id, err = s.importRunRepository.Start(run); err != nil {
+		return hold, fmt.Errorf("failed to start import run: %w", err)
+	}
+	run.Finish(models.ImportResult{Updated: []models.ImportRow{change.ImportRow}}, nil)
+	if err := s.importRunRepository.Finish(run, []models.ImportChange{change}, nil); err != nil {
+		return hold, fmt.Errorf("failed to record import run %d: %w", run.Id, err)
+	}
+
+	if err := s.priceHistoryRepository.Record(models.PricePoints([]models.ImportChange{change})); err != nil {
+		return hold, fmt.Errorf("failed to record price history: %w", err)
+	}
+
+	stats, err := s.productService.ReindexProducts(ctx, []int{hold.ProductId})
+	if err == nil && stats.Failed > 0 {
+		err = fmt.Errorf("failed to index product %d", hold.ProductId)
+	}
+	if err != nil {
+		return hold, fmt.Errorf("failed to reindex product: %w", err)
+	}
+	return hold, nil
+}
+
+// Reject отклоняет задержанное изменение, цена и остаток остаются прежними.
+func (s *priceHoldService) Reject(ctx context.Context, id int) (models.PriceHold, error) {
+	if err := s.priceHoldRepository.Reject(id); err != nil {
+		return models.PriceHold{}, holdError(err)
+	}
+	return s.get(id)
+}
+
+func (s *priceHoldService) get(id int) (models.PriceHold, error) {
+	hold, err := s.priceHoldRepository.Get(id)
+	if err != nil {
+		return models.PriceHold{}, holdError(err)
+	}
+	return hold, nil
+}
+
+func holdError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+		return ErrHoldNotFound
+	case errors.Is(err, repositories.ErrHoldResolved):
+		return ErrHoldResolved
+	case errors.Is(err, repositories.ErrHoldStale):
+		return ErrHoldStale
+	}
+	return err
+}
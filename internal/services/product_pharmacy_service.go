@@ -1,8 +1,10 @@
 package services
 
 import (
+	"aurma_product/internal/elastic"
 	"aurma_product/internal/models"
 	"aurma_product/internal/models/elasticModels"
+	"context"
 	"fmt"
 	"log"
 	"sync"
@@ -54,7 +56,8 @@ func (s *productService) UpdatedProductPharmacies() ([]elasticModels.Product, er
 	for _, pharmacy := range pharmacies {
 		index := fmt.Sprintf("%d_%d", pharmacy.ProductId, pharmacy.PharmacyId)
 		old, exists := productPharmacies[index]
-		if !exists || old.Price != pharmacy.Price || old.Count != pharmacy.Count {
+		if !exists || old.Price != pharmacy.Price || old.Count != pharmacy.Count || old.IsHidden != pharmacy.IsHidden ||
+			old.ExpirationDate != pharmacy.ExpirationDate {
 			newProductPharmacies = append(newProductPharmacies, pharmacy)
 		}
 		productPharmacies[index] = pharmacy
@@ -65,15 +68,78 @@ func (s *productService) UpdatedProductPharmacies() ([]elasticModels.Product, er
 		return nil, nil
 	}
 
-	products := make([]elasticModels.Product, 0, len(newProductPharmacies))
+	// История пишется только при изменении, поэтому строки, которые уже записал импорт
+	// или которые после перезапуска впервые попали в кеш, новых точек не добавят.
+	points := make([]models.PricePoint, len(newProductPharmacies))
+	for i, pharmacy := range newProductPharmacies {
+		points[i] = models.PricePoint{ProductId: pharmacy.ProductId, PharmacyId: pharmacy.PharmacyId, Price: pharmacy.Price, Count: pharmacy.Count}
+		if pharmacy.IsHidden {
+			points[i].Count = 0
+		}
+	}
+	if err := s.priceHistoryRepository.Record(points); err != nil {
+		log.Printf("Error recording price history: %v", err)
+	}
+
+	// Документ продукта пересобирается по всем его аптекам, а не по измененной строке,
+	// иначе цена и остаток последней обработанной аптеки перезаписали бы сводку.
+	productIds := make([]int, 0, len(newProductPharmacies))
+	seen := make(map[int]bool, len(newProductPharmacies))
 	for _, value := range newProductPharmacies {
-		product, err := s.productRepository.GetByIdSearchData(value.ProductId)
+		if !seen[value.ProductId] {
+			seen[value.ProductId] = true
+			productIds = append(productIds, value.ProductId)
+		}
+	}
+
+	return s.searchDocuments(productIds)
+}
+
+// ReindexProducts пересобирает документы указанных продуктов по всем их аптекам и
+// записывает их в индекс, не трогая остальные продукты.
+func (s *productService) ReindexProducts(ctx context.Context, productIds []int) (elastic.BulkStats, error) {
+	return s.indexProducts(ctx, elastic.IndexName, productIds)
+}
+
+// indexProducts пересобирает документы продуктов в указанном индексе или алиасе.
+func (s *productService) indexProducts(ctx context.Context, index string, productIds []int) (elastic.BulkStats, error) {
+	products, err := s.searchDocuments(productIds)
+	if err != nil {
+		return elastic.BulkStats{}, err
+	}
+	if len(products) == 0 {
+		return elastic.BulkStats{}, nil
+	}
+	stats, err := s.elastic.ProductAddDocumentToIndex(ctx, index, products)
+	if err != nil {
+		return stats, fmt.Errorf("failed to index products: %w", err)
+	}
+	for _, e := range stats.Errors {
+		log.Printf("Error indexing product %s: [%d] %s", e.Id, e.Status, e.Reason)
+	}
+	return stats, nil
+}
+
+// searchDocuments собирает документы индекса для продуктов со сводкой и предложениями аптек.
+func (s *productService) searchDocuments(productIds []int) ([]elasticModels.Product, error) {
+	aggregates, err := s.productRepository.ProductPharmacyAggregates(productIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product pharmacy aggregates: %w", err)
+	}
+	offers, err := s.productRepository.ProductPharmaciesByProducts(productIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product offers: %w", err)
+	}
+
+	products := make([]elasticModels.Product, 0, len(productIds))
+	for _, productId := range productIds {
+		product, err := s.productRepository.GetByIdSearchData(productId)
 		if err != nil {
-			log.Printf("Error getting product %d: %v", value.ProductId, err)
+			log.Printf("Error getting product %d: %v", productId, err)
 			continue
 		}
 
-		products = append(products, product.ToProductElastic(value.Price, value.Count))
+		products = append(products, product.ToProductElastic(aggregates[productId], offers[productId]))
 	}
 
 	return products, nil
@@ -90,6 +156,9 @@ func (s *productService) ProductPharmaciesList() []elasticModels.Product {
 	products = make([]elasticModels.Product, 0, len(productPharmacies))
 
 	for _, value := range productPharmacies {
+		if value.IsHidden {
+			continue
+		}
 		wg.Add(1)
 		go func(value models.ProductPharmacy) {
 			defer wg.Done()
@@ -5,41 +5,173 @@ import (
 	"aurma_product/internal/models"
 	"aurma_product/internal/models/elasticModels"
 	"aurma_product/internal/repositories"
+	"aurma_product/pkg/gs1"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/antibomberman/dblayer"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type productService struct {
-	productRepository repositories.ProductRepository
-	dblayer           *dblayer.DBLayer
-	elastic           *elastic.Elastic
+	productRepository      repositories.ProductRepository
+	priceHistoryRepository repositories.PriceHistoryRepository
+	dblayer                *dblayer.DBLayer
+	elastic                *elastic.Elastic
 }
 
-func NewProductService(dblayer *dblayer.DBLayer, productRepo repositories.ProductRepository, es *elastic.Elastic) ProductService {
-	return &productService{productRepository: productRepo, dblayer: dblayer, elastic: es}
+func NewProductService(dblayer *dblayer.DBLayer, productRepo repositories.ProductRepository, priceHistoryRepo repositories.PriceHistoryRepository, es *elastic.Elastic) ProductService {
+	return &productService{productRepository: productRepo, priceHistoryRepository: priceHistoryRepo, dblayer: dblayer, elastic: es}
 }
 
-func (s *productService) Search(ctx context.Context, text string, from, size int, sort string, minPrice, maxPrice int) ([]models.ProductDetail, int, error) {
-	elasticProducts, total, err := s.elastic.ProductSearch(ctx, text, from, size, sort, minPrice, maxPrice)
+func (s *productService) Search(ctx context.Context, params elasticModels.ProductSearchParams) (models.ProductSearchResult, error) {
+	result, err := s.elastic.ProductSearch(ctx, params)
 	if err != nil {
-		return nil, total, fmt.Errorf("failed to search product IDs: %w", err)
+		if errors.Is(err, elastic.ErrInvalidCursor) || errors.Is(err, elastic.ErrCursorExpired) ||
+			errors.Is(err, elastic.ErrResultWindowExceeded) {
+			return models.ProductSearchResult{}, fmt.Errorf("%w: %v", ErrInvalidPagination, err)
+		}
+		return models.ProductSearchResult{}, fmt.Errorf("failed to search product IDs: %w", err)
 	}
-	productDetails := make([]models.ProductDetail, len(elasticProducts))
+	productDetails := make([]models.ProductDetail, len(result.Products))
 
-	for i, elasticProduct := range elasticProducts {
+	for i, elasticProduct := range result.Products {
 		images, _ := s.GetImages(elasticProduct.Id)
-		productDetails[i] = elasticProduct.ToProductDetail(images)
+		productDetails[i] = models.ProductDetailFromElastic(elasticProduct, images)
+	}
+
+	return models.ProductSearchResult{
+		Products:   productDetails,
+		Total:      result.Total,
+		Facets:     result.Facets,
+		NextCursor: result.NextCursor,
+	}, nil
+
+}
+
+// Suggest возвращает подсказки для строки поиска без загрузки изображений.
+func (s *productService) Suggest(ctx context.Context, text string, limit int) ([]elasticModels.Suggestion, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	suggestions, err := s.elastic.ProductSuggest(ctx, text, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suggestions: %w", err)
+	}
+	return suggestions, nil
+}
+
+// ScanBarcode разбирает EAN/GTIN или строку GS1 DataMatrix и ищет продукт
+// по точному совпадению GTIN.
+func (s *productService) ScanBarcode(ctx context.Context, code string) (models.BarcodeScan, error) {
+	parsed, err := gs1.Parse(code)
+	if err != nil {
+		return models.BarcodeScan{}, fmt.Errorf("%w: %v", ErrInvalidBarcode, err)
+	}
+
+	product, found, err := s.elastic.ProductByBarcode(ctx, gs1.Forms(parsed.GTIN))
+	if err != nil {
+		return models.BarcodeScan{}, fmt.Errorf("failed to search product by barcode: %w", err)
+	}
+	if !found {
+		return models.BarcodeScan{}, fmt.Errorf("%w: barcode %s", ErrProductNotFound, parsed.GTIN)
+	}
+
+	images, _ := s.GetImages(product.Id)
+
+	return models.BarcodeScan{
+		Product: models.ProductDetailFromElastic(product, images),
+		Gtin:    parsed.GTIN,
+		Expiry:  parsed.Expiry,
+		Serial:  parsed.Serial,
+		Batch:   parsed.Batch,
+	}, nil
+}
+
+// Analogs возвращает дженерики продукта: продукты с тем же набором МНН.
+func (s *productService) Analogs(ctx context.Context, productID int, sameIssueForm bool, limit int) ([]models.ProductDetail, error) {
+	product, err := s.productRepository.GetByIdSearchData(productID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrProductNotFound, productID)
+		}
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	if !product.Mnn.Valid || product.Mnn.String == "" {
+		return nil, nil
+	}
+	if limit <= 0 {
+		limit = 20
 	}
 
-	return productDetails, total, nil
+	issueForm := ""
+	if sameIssueForm {
+		issueForm = product.IssueForm.String
+	}
 
+	elasticProducts, err := s.elastic.ProductAnalogs(ctx, productID, product.Mnn.String, issueForm, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search analogs: %w", err)
+	}
+
+	productDetails := make([]models.ProductDetail, len(elasticProducts))
+	for i, elasticProduct := range elasticProducts {
+		images, _ := s.GetImages(elasticProduct.Id)
+		productDetails[i] = models.ProductDetailFromElastic(elasticProduct, images)
+	}
+	return productDetails, nil
 }
 
+// Show возвращает карточку продукта по числовому ID или slug.
 func (s *productService) Show(ctx context.Context, id string) (models.ProductDetail, error) {
-	// Реализация метода Show
-	return models.ProductDetail{}, nil
+	product, err := s.findSearchData(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.ProductDetail{}, fmt.Errorf("%w: %s", ErrProductNotFound, id)
+		}
+		return models.ProductDetail{}, fmt.Errorf("failed to get product %s: %w", id, err)
+	}
+
+	aggregates, err := s.productRepository.ProductPharmacyAggregates([]int{product.Id})
+	if err != nil {
+		return models.ProductDetail{}, fmt.Errorf("failed to get product pharmacy aggregates: %w", err)
+	}
+	aggregate := aggregates[product.Id]
+
+	images, _ := s.GetImages(product.Id)
+
+	return product.ToProductDetail(aggregate.MinPrice, aggregate.TotalCount, images), nil
+}
+
+// findSearchData ищет продукт сначала по ID, если строка числовая, затем по slug.
+func (s *productService) findSearchData(idOrSlug string) (models.ProductSearchWithData, error) {
+	if id, err := strconv.Atoi(idOrSlug); err == nil && id > 0 {
+		product, err := s.productRepository.GetByIdSearchData(id)
+		if err == nil || !errors.Is(err, repositories.ErrNotFound) {
+			return product, err
+		}
+	}
+	return s.productRepository.GetBySlugSearchData(idOrSlug)
+}
+
+// Offers возвращает предложения всех аптек по продукту. Положительный minShelfLifeDays
+// убирает предложения, срок годности которых истекает раньше чем через столько дней.
+func (s *productService) Offers(ctx context.Context, productID int, sort string, inStockOnly bool, minShelfLifeDays int) ([]models.ProductPharmacy, error) {
+	if _, err := s.productRepository.GetById(productID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrProductNotFound, productID)
+		}
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+
+	offers, err := s.productRepository.ProductPharmacyOffers(productID, sort, inStockOnly, minShelfLifeDays)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offers: %w", err)
+	}
+	return offers, nil
 }
 
 func (s *productService) GetImages(productID int) ([]models.ProductImage, error) {
@@ -78,31 +210,136 @@ func (s *productService) GetImages(productID int) ([]models.ProductImage, error)
 }
 
 func (s *productService) SetAllProductToElastic() error {
+	stats, err := s.indexAllProducts(context.Background(), elastic.IndexName)
+	if err != nil {
+		return err
+	}
+	if stats.Failed > 0 {
+		return fmt.Errorf("failed to index %d of %d products", stats.Failed, stats.Indexed+stats.Failed)
+	}
+	return nil
+}
+
+// ReindexElastic заполняет новую версию индекса в фоне, сверяет количество документов
+// и атомарно переключает на нее алиас. Поиск все это время работает по старой версии.
+// Обновления, которые за время заполнения ушли в старую версию через алиас, догоняются
+// в новой до переключения и еще раз сразу после него.
+func (s *productService) ReindexElastic(ctx context.Context, deleteOld bool) (string, error) {
+	index, err := s.elastic.ProductCreateIndexVersion(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create index version: %w", err)
+	}
+	log.Printf("Reindexing products into %s", index)
+
+	since := time.Now()
+	stats, err := s.indexAllProducts(ctx, index)
+	if err != nil {
+		return "", fmt.Errorf("failed to fill index %s: %w", index, err)
+	}
+	if stats.Failed > 0 {
+		return "", fmt.Errorf("failed to index %d products into %s; alias not switched", stats.Failed, index)
+	}
+
+	count, err := s.elastic.ProductIndexCount(ctx, index)
+	if err != nil {
+		return "", fmt.Errorf("failed to count documents in %s: %w", index, err)
+	}
+	if count != stats.Indexed {
+		return "", fmt.Errorf("index %s has %d documents, expected %d; alias not switched", index, count, stats.Indexed)
+	}
+
+	if since, err = s.catchUp(ctx, index, since); err != nil {
+		return "", fmt.Errorf("failed to catch up index %s: %w; alias not switched", index, err)
+	}
+
+	previous, err := s.elastic.ProductSwitchAlias(ctx, index)
+	if err != nil {
+		return "", fmt.Errorf("failed to switch alias to %s: %w", index, err)
+	}
+	log.Printf("Alias %s switched from %q to %s", elastic.IndexName, previous, index)
+
+	// Изменения между догоняющим проходом и переключением алиаса попали только в старую версию.
+	if _, err := s.catchUp(ctx, index, since); err != nil {
+		return index, fmt.Errorf("failed to catch up index %s after switch: %w", index, err)
+	}
+
+	if deleteOld && previous != "" {
+		if err := s.elastic.ProductDeleteIndex(ctx, previous); err != nil {
+			return index, fmt.Errorf("failed to delete old index %s: %w", previous, err)
+		}
+	}
+	return index, nil
+}
+
+// catchUp переиндексирует в index продукты, измененные с since, и возвращает момент
+// начала прохода, с которого нужно догонять в следующий раз.
+func (s *productService) catchUp(ctx context.Context, index string, since time.Time) (time.Time, error) {
+	started := time.Now()
+	productIds, err := s.productRepository.ProductIdsUpdatedSince(since)
+	if err != nil {
+		return since, err
+	}
+	const batchSize = 1000
+	for start := 0; start < len(productIds); start += batchSize {
+		stats, err := s.indexProducts(ctx, index, productIds[start:min(start+batchSize, len(productIds))])
+		if err != nil {
+			return since, err
+		}
+		if stats.Failed > 0 {
+			return since, fmt.Errorf("failed to index %d of %d updated products", stats.Failed, stats.Indexed+stats.Failed)
+		}
+	}
+	log.Printf("Caught up %d products updated during reindex into %s", len(productIds), index)
+	return started, nil
+}
+
+// indexAllProducts выгружает все продукты в указанный индекс и возвращает
+// итог индексации за весь прогон.
+func (s *productService) indexAllProducts(ctx context.Context, index string) (elastic.BulkStats, error) {
 	offset := 0
 	limit := 10000
+	var total elastic.BulkStats
 	for {
 		products, err := s.productRepository.AllProductSearchData(offset, limit)
 		if err != nil {
-			return fmt.Errorf("failed to get products: %w", err)
+			return total, fmt.Errorf("failed to get products: %w", err)
 		}
 		if len(products) == 0 {
 			break
 		}
 
+		productIds := make([]int, len(products))
+		for i, product := range products {
+			productIds[i] = product.Id
+		}
+		aggregates, err := s.productRepository.ProductPharmacyAggregates(productIds)
+		if err != nil {
+			return total, fmt.Errorf("failed to get product pharmacy aggregates: %w", err)
+		}
+		offers, err := s.productRepository.ProductPharmaciesByProducts(productIds)
+		if err != nil {
+			return total, fmt.Errorf("failed to get product offers: %w", err)
+		}
+
 		esProducts := make([]elasticModels.Product, 0, len(products))
 		for _, product := range products {
-			log.Printf("Indexing product: %d", product.Id)
-			productPharmacy, _ := s.productRepository.ProductPharmacy(product.Id)
-			esProducts = append(esProducts, product.ToProductElastic(productPharmacy.Price, productPharmacy.Count))
+			esProducts = append(esProducts, product.ToProductElastic(aggregates[product.Id], offers[product.Id]))
 		}
 
-		if err := s.elastic.ProductAddDocument(esProducts); err != nil {
-			log.Printf("Error indexing products to Elasticsearch: %v", err)
-			// Consider whether to return error here or continue with next batch
+		stats, err := s.elastic.ProductAddDocumentToIndex(ctx, index, esProducts)
+		if err != nil {
+			return total, fmt.Errorf("failed to index products: %w", err)
+		}
+		for _, e := range stats.Errors {
+			log.Printf("Error indexing product %s: [%d] %s", e.Id, e.Status, e.Reason)
 		}
+		log.Printf("Indexed products %d-%d: %d indexed, %d failed", offset, offset+len(products), stats.Indexed, stats.Failed)
+		total.Indexed += stats.Indexed
+		total.Failed += stats.Failed
 
 		offset += limit
 	}
 
-	return nil
+	log.Printf("Indexing into %s finished: %d indexed, %d failed", index, total.Indexed, total.Failed)
+	return total, nil
 }
@@ -1,51 +1,42 @@
 package services
 
 import (
+	"aurma_product/internal/models"
 	"aurma_product/internal/models/sadykhanModels"
-	"aurma_product/internal/repositories"
-	"bytes"
+	"aurma_product/internal/suppliers"
 	"context"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
-	"github.com/antibomberman/dblayer"
 	"io"
 	"strings"
-	"sync"
-	"time"
 )
 
 type sadykhanService struct {
-	productRepository repositories.ProductRepository
-	dblayer           *dblayer.DBLayer
+	supplierService SupplierService
 }
 
-func NewSadykhanService(dblayer *dblayer.DBLayer, productRepo repositories.ProductRepository) SadykhanService {
-	return &sadykhanService{productRepository: productRepo, dblayer: dblayer}
+func NewSadykhanService(supplierService SupplierService) SadykhanService {
+	return &sadykhanService{supplierService: supplierService}
 }
 
 func (s *sadykhanService) Parse(ctx context.Context, data io.Reader, contentType string) (*sadykhanModels.Catalog, error) {
 	if contentType == "" {
 		return nil, fmt.Errorf("content type is empty")
 	}
-	content, err := io.ReadAll(data)
-	if err != nil {
-		return nil, fmt.Errorf("error reading data: %w", err)
-	}
 
 	var catalog sadykhanModels.Catalog
 
-	contentType = strings.ToLower(contentType)
 	switch strings.ToLower(contentType) {
 	case "xml":
-		if err := xml.Unmarshal(content, &struct {
+		if err := xml.NewDecoder(data).Decode(&struct {
 			*sadykhanModels.Catalog
 			XMLName xml.Name `xml:"rocketpharm_catalog"`
 		}{Catalog: &catalog}); err != nil {
 			return nil, fmt.Errorf("error parsing XML: %w", err)
 		}
 	case "json":
-		if err := json.NewDecoder(bytes.NewReader(content)).Decode(&catalog); err != nil {
+		if err := json.NewDecoder(data).Decode(&catalog); err != nil {
 			return nil, fmt.Errorf("error parsing JSON: %w", err)
 		}
 	default:
@@ -55,55 +46,17 @@ func (s *sadykhanService) Parse(ctx context.Context, data io.Reader, contentType
 	return &catalog, nil
 }
 
-func (s *sadykhanService) Create(ctx context.Context, catalog *sadykhanModels.Catalog) error {
-
-	errChan := make(chan error, len(catalog.Offers))
-	wg := &sync.WaitGroup{}
-
-	wg.Add(len(catalog.Offers))
-	for _, offer := range catalog.Offers {
-		go func(offer sadykhanModels.Offer) {
-			defer wg.Done()
-			if offer.SKU == "" {
-				return
-			}
-			exists, err := s.dblayer.Exists(ctx, "product_pharmacy", []dblayer.Condition{{"sku", "=", offer.SKU}})
-
-			if !exists {
-				errChan <- fmt.Errorf("product with SKU %s not exists", offer.SKU)
-				return
-			}
-			count := 0
-			if offer.Availabilities.Available == "YES" {
-				count = offer.Availabilities.Availability
-			}
-			price := 0
-			if offer.CityPrices.CityPrice > 0 {
-				price = offer.CityPrices.CityPrice
-			}
-
-			record, err := s.dblayer.UpdateRecord(ctx, "product_pharmacy", map[string]interface{}{
-				"price":      price,
-				"count":      count,
-				"updated_at": time.Now(),
-			}, []dblayer.Condition{{"sku", "=", offer.SKU}})
-			if err != nil {
-				errChan <- fmt.Errorf("error updating for SKU %s: %w", offer.SKU, err)
-				return
-			}
-			fmt.Printf("Updated product with SKU %s: %+v\n", offer.SKU, record)
-
-		}(offer)
-	}
-	wg.Wait()
+// Import применяет фид Садыхан через общий импорт поставщиков.
+func (s *sadykhanService) Import(ctx context.Context, source string, data io.Reader, contentType string) (models.ImportResult, error) {
+	return s.supplierService.Import(ctx, suppliers.SadykhanName, source, data, contentType)
+}
 
-	var errs []error
-	for err := range errChan {
-		errs = append(errs, err)
-	}
+// DryRun сверяет фид Садыхан с базой, ничего не записывая.
+func (s *sadykhanService) DryRun(ctx context.Context, data io.Reader, contentType string) (models.ImportDiff, error) {
+	return s.supplierService.DryRun(ctx, suppliers.SadykhanName, data, contentType)
+}
 
-	if len(errs) > 0 {
-		return fmt.Errorf("encountered %d errors during processing: %v", len(errs), errs)
-	}
-	return nil
+// Sync применяет полный фид Садыхан и снимает с продажи отсутствующие в нем строки.
+func (s *sadykhanService) Sync(ctx context.Context, source string, data io.Reader, contentType string, options models.SyncOptions) (models.ImportResult, error) {
+	return s.supplierService.Sync(ctx, suppliers.SadykhanName, source, data, contentType, options)
 }
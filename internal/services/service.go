@@ -1,25 +1,105 @@
 package services
 
 import (
+	"aurma_product/internal/elastic"
 	"aurma_product/internal/models"
 	"aurma_product/internal/models/elasticModels"
 	"aurma_product/internal/models/sadykhanModels"
 	"context"
+	"errors"
 	"io"
+	"time"
+)
+
+var (
+	// ErrProductNotFound возвращается, когда продукт не найден ни по ID, ни по slug.
+	ErrProductNotFound = errors.New("product not found")
+	// ErrInvalidBarcode возвращается, когда штрихкод не удалось разобрать.
+	ErrInvalidBarcode = errors.New("invalid barcode")
+	// ErrInvalidPagination возвращается для испорченного курсора или слишком глубокой страницы.
+	ErrInvalidPagination = errors.New("invalid pagination")
+	// ErrMatchNotFound возвращается, когда записи очереди сопоставления нет.
+	ErrMatchNotFound = errors.New("supplier match not found")
+	// ErrMatchResolved возвращается при повторном решении по записи очереди.
+	ErrMatchResolved = errors.New("supplier match already resolved")
+	// ErrMatchNoCandidate возвращается, когда продукт не указан, а кандидатов нет.
+	ErrMatchNoCandidate = errors.New("supplier match has no candidates")
+	// ErrNoStores возвращается при импорте фида поставщика, у которого не привязан ни один склад.
+	ErrNoStores = errors.New("no stores mapped for supplier")
+	// ErrStoreNotFound возвращается, когда привязки склада поставщика нет.
+	ErrStoreNotFound = errors.New("supplier store not found")
+	// ErrSyncThreshold возвращается, когда полная сверка сняла бы с продажи слишком много строк.
+	ErrSyncThreshold = errors.New("too many supplier rows missing from feed")
+	// ErrSyncUnreadable возвращается, когда в фиде были офферы без SKU и отсутствующие строки не определить.
+	ErrSyncUnreadable = errors.New("feed has unreadable offers")
+	// ErrImportRunNotFound возвращается, когда запуска импорта нет в истории.
+	ErrImportRunNotFound = errors.New("import run not found")
+	// ErrImportRunRolledBack возвращается при повторном откате запуска импорта.
+	ErrImportRunRolledBack = errors.New("import run already rolled back")
+	// ErrImportRunRunning возвращается при попытке откатить незавершенный запуск.
+	ErrImportRunRunning = errors.New("import run is still running")
+	// ErrHoldNotFound возвращается, когда задержанного изменения нет.
+	ErrHoldNotFound = errors.New("price hold not found")
+	// ErrHoldResolved возвращается при повторном решении по задержанному изменению.
+	ErrHoldResolved = errors.New("price hold already resolved")
+	// ErrHoldStale возвращается, когда строка изменилась после задержки и одобрять нечего.
+	ErrHoldStale = errors.New("product pharmacy changed since the hold")
 )
 
 // ProductService определяет интерфейс для сервиса работы с продуктами.
 type ProductService interface {
-	Search(ctx context.Context, query string, from, size int, sort string, minPrice, maxPrice int) ([]models.ProductDetail, int, error)
+	Search(ctx context.Context, params elasticModels.ProductSearchParams) (models.ProductSearchResult, error)
 	Show(ctx context.Context, id string) (models.ProductDetail, error)
+	Suggest(ctx context.Context, text string, limit int) ([]elasticModels.Suggestion, error)
+	ScanBarcode(ctx context.Context, code string) (models.BarcodeScan, error)
+	Analogs(ctx context.Context, productID int, sameIssueForm bool, limit int) ([]models.ProductDetail, error)
+	Offers(ctx context.Context, productID int, sort string, inStockOnly bool, minShelfLifeDays int) ([]models.ProductPharmacy, error)
 	GetImages(productID int) ([]models.ProductImage, error)
 	InitTotalProductPharmaciesList() error
 	UpdatedProductPharmacies() ([]elasticModels.Product, error)
 	ProductPharmaciesList() []elasticModels.Product
 	SetAllProductToElastic() error
+	ReindexElastic(ctx context.Context, deleteOld bool) (string, error)
+	ReindexProducts(ctx context.Context, productIds []int) (elastic.BulkStats, error)
 }
 
 type SadykhanService interface {
 	Parse(ctx context.Context, data io.Reader, contentType string) (*sadykhanModels.Catalog, error)
-	Create(ctx context.Context, catalog *sadykhanModels.Catalog) error
+	Import(ctx context.Context, source string, data io.Reader, contentType string) (models.ImportResult, error)
+	DryRun(ctx context.Context, data io.Reader, contentType string) (models.ImportDiff, error)
+	Sync(ctx context.Context, source string, data io.Reader, contentType string, options models.SyncOptions) (models.ImportResult, error)
+}
+
+type SupplierService interface {
+	Suppliers() []string
+	Import(ctx context.Context, supplier, source string, data io.Reader, contentType string) (models.ImportResult, error)
+	DryRun(ctx context.Context, supplier string, data io.Reader, contentType string) (models.ImportDiff, error)
+	Sync(ctx context.Context, supplier, source string, data io.Reader, contentType string, options models.SyncOptions) (models.ImportResult, error)
+	Runs(ctx context.Context, supplier string, limit, offset int) ([]models.ImportRun, error)
+	Run(ctx context.Context, id int, sku string) (models.ImportRun, error)
+	Rollback(ctx context.Context, id int, force bool) (models.ImportResult, error)
+	Stores(ctx context.Context, supplier string) ([]models.SupplierStore, error)
+	SetStore(ctx context.Context, supplier, storeId string, pharmacyId int) error
+	DeleteStore(ctx context.Context, supplier, storeId string) error
+}
+
+// MatchService очередь сопоставления неизвестных SKU поставщиков с продуктами.
+type MatchService interface {
+	Enqueue(ctx context.Context, matches []models.SupplierMatch) error
+	Matches(ctx context.Context, supplier, status string, limit, offset int) ([]models.SupplierMatch, error)
+	Accept(ctx context.Context, id, productId int) (models.SupplierMatch, error)
+	Reject(ctx context.Context, id int) (models.SupplierMatch, error)
+}
+
+// PriceHoldService задержанные правилами проверки изменения цены и остатка из фидов.
+type PriceHoldService interface {
+	Holds(ctx context.Context, supplier, status string, limit, offset int) ([]models.PriceHold, error)
+	Approve(ctx context.Context, id int) (models.PriceHold, error)
+	Reject(ctx context.Context, id int) (models.PriceHold, error)
+}
+
+// PriceHistoryService история цен и остатков продуктов в аптеках.
+type PriceHistoryService interface {
+	Trend(ctx context.Context, productId, pharmacyId int, from, to time.Time) (models.PriceTrend, error)
+	Prune(ctx context.Context) (int64, error)
 }
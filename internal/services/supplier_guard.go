@@ -0,0 +1,43 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"fmt"
+	"math"
+)
+
+// GuardConfig правила проверки изменений из фида. Изменение, нарушившее правило,
+// не записывается, а задерживается до решения администратора. Нулевое значение
+// отключает правило.
+type GuardConfig struct {
+	// MinPrice цены ниже этой, в том числе нулевые и отрицательные, задерживаются.
+	MinPrice int
+	// MaxPriceChangePercent задерживается изменение цены больше чем на столько процентов.
+	MaxPriceChangePercent float64
+	// MaxStockFactor задерживается рост остатка больше чем в столько раз.
+	MaxStockFactor float64
+	// MinStockSpike рост остатка меньше чем на столько штук скачком не считается.
+	MinStockSpike int
+}
+
+// check возвращает нарушенное изменением правило или пустую строку.
+// Появление остатка с нуля скачком не считается: это обычное поступление.
+func (g GuardConfig) check(change models.ImportChange) string {
+	if g.MinPrice > 0 && change.NewPrice < g.MinPrice && change.NewPrice != change.OldPrice {
+		if change.NewPrice <= 0 {
+			return "zero or negative price"
+		}
+		return fmt.Sprintf("price below %d", g.MinPrice)
+	}
+	if g.MaxPriceChangePercent > 0 && change.OldPrice > 0 {
+		percent := math.Abs(float64(change.NewPrice-change.OldPrice)) / float64(change.OldPrice) * 100
+		if percent > g.MaxPriceChangePercent {
+			return fmt.Sprintf("price changed by %.1f%%", percent)
+		}
+	}
+	if g.MaxStockFactor > 0 && change.OldCount > 0 && change.NewCount-change.OldCount >= g.MinStockSpike &&
+		float64(change.NewCount) > float64(change.OldCount)*g.MaxStockFactor {
+		return fmt.Sprintf("stock spike from %d to %d", change.OldCount, change.NewCount)
+	}
+	return ""
+}
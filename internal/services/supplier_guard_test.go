@@ -0,0 +1,42 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"testing"
+)
+
+func TestGuardConfigCheck(t *testing.T) {
+	guard := GuardConfig{MinPrice: 50, MaxPriceChangePercent: 30, MaxStockFactor: 10, MinStockSpike: 100}
+	change := func(oldPrice, newPrice, oldCount, newCount int) models.ImportChange {
+		return models.ImportChange{OldPrice: oldPrice, NewPrice: newPrice, OldCount: oldCount, NewCount: newCount}
+	}
+
+	tests := []struct {
+		name   string
+		guard  GuardConfig
+		change models.ImportChange
+		want   string
+	}{
+		{name: "small price change", guard: guard, change: change(1000, 1200, 5, 6), want: ""},
+		{name: "zero price", guard: guard, change: change(1000, 0, 5, 5), want: "zero or negative price"},
+		{name: "negative price", guard: guard, change: change(1000, -10, 5, 5), want: "zero or negative price"},
+		{name: "price below minimum", guard: guard, change: change(40, 45, 5, 5), want: "price below 50"},
+		{name: "unchanged low price passes", guard: guard, change: change(40, 40, 5, 8), want: ""},
+		{name: "price jump", guard: guard, change: change(1000, 1500, 5, 5), want: "price changed by 50.0%"},
+		{name: "price drop", guard: guard, change: change(1000, 600, 5, 5), want: "price changed by 40.0%"},
+		{name: "price exactly at limit", guard: guard, change: change(1000, 1300, 5, 5), want: ""},
+		{name: "first price from zero", guard: guard, change: change(0, 5000, 0, 5), want: ""},
+		{name: "stock spike", guard: guard, change: change(1000, 1000, 20, 500), want: "stock spike from 20 to 500"},
+		{name: "small stock growth by factor", guard: guard, change: change(1000, 1000, 2, 50), want: ""},
+		{name: "stock from zero", guard: guard, change: change(1000, 1000, 0, 5000), want: ""},
+		{name: "stock drop", guard: guard, change: change(1000, 1000, 500, 1), want: ""},
+		{name: "disabled rules", guard: GuardConfig{}, change: change(1000, 0, 1, 100000), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.guard.check(tt.change); got != tt.want {
+				t.Errorf("check() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
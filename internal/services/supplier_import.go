@@ -0,0 +1,468 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"aurma_product/internal/repositories"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/antibomberman/dblayer"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ImportConfig настройки применения фидов поставщиков.
+type ImportConfig struct {
+	// Workers число пачек, применяемых одновременно.
+	Workers int
+	// BatchSize число строк фида в одной пачке.
+	BatchSize int
+	// MaxWithdrawPercent порог полной сверки по умолчанию, в процентах строк поставщика.
+	MaxWithdrawPercent float64
+	// Guard правила, по которым подозрительные изменения задерживаются.
+	Guard GuardConfig
+}
+
+func (c ImportConfig) withDefaults() ImportConfig {
+	if c.Workers <= 0 {
+		c.Workers = 4
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 500
+	}
+	if c.MaxWithdrawPercent <= 0 {
+		c.MaxWithdrawPercent = 20
+	}
+	return c
+}
+
+// importRow строка фида, привязанная к аптеке.
+type importRow struct {
+	models.ImportRow
+	Price int
+	Count int
+	// Expiration срок годности из фида, пустой если поставщик его не прислал.
+	Expiration sql.NullTime
+	Title      string
+	Brand      string
+	Barcodes   []string
+}
+
+type rowKey struct {
+	sku        string
+	pharmacyId int
+}
+
+// importer применяет строки фида к product_pharmacy пачками в пуле воркеров.
+type importer struct {
+	productRepository repositories.ProductRepository
+	dblayer           *dblayer.DBLayer
+	config            ImportConfig
+	// supplier поставщик фида: строки, найденные в фиде, закрепляются за ним.
+	supplier string
+	// dryRun только сверяет фид с базой, ничего не записывая.
+	dryRun bool
+
+	mu      sync.Mutex
+	result  models.ImportResult
+	changes []models.ImportChange
+	unknown []importRow
+	seen    map[rowKey]bool
+	// seenSkus SKU битых офферов: их строки считаются найденными в фиде во всех аптеках.
+	seenSkus map[string]bool
+	// unreadable число битых офферов без SKU, по которым не понять, какие строки были в фиде.
+	unreadable int
+}
+
+func newImporter(dblayer *dblayer.DBLayer, productRepo repositories.ProductRepository, supplier string, config ImportConfig, dryRun bool) *importer {
+	return &importer{
+		productRepository: productRepo,
+		dblayer:           dblayer,
+		config:            config.withDefaults(),
+		supplier:          supplier,
+		dryRun:            dryRun,
+		seen:              make(map[rowKey]bool),
+		seenSkus:          make(map[string]bool),
+	}
+}
+
+// run читает строки из канала до его закрытия и возвращает итог.
+func (im *importer) run(ctx context.Context, rows <-chan importRow) models.ImportResult {
+	batches := make(chan []importRow, im.config.Workers)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < im.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				im.apply(ctx, batch)
+			}
+		}()
+	}
+
+	batch := make([]importRow, 0, im.config.BatchSize)
+	for row := range rows {
+		batch = append(batch, row)
+		if len(batch) == im.config.BatchSize {
+			batches <- batch
+			batch = make([]importRow, 0, im.config.BatchSize)
+		}
+	}
+	if len(batch) > 0 {
+		batches <- batch
+	}
+	close(batches)
+	wg.Wait()
+
+	return im.result
+}
+
+// fail записывает строку фида, которую не удалось применить.
+func (im *importer) fail(row models.ImportRow, line int, err error) {
+	im.mu.Lock()
+	im.result.Failed = append(im.result.Failed, models.ImportFailure{ImportRow: row, Line: line, Error: err.Error()})
+	im.mu.Unlock()
+}
+
+// skip записывает оффер, который не удалось разобрать. Его строки во всех аптеках
+// считаются найденными в фиде: полная сверка не должна снимать SKU из-за битой цены.
+func (im *importer) skip(sku string, line int, err error) {
+	im.mu.Lock()
+	if sku != "" {
+		im.seenSkus[sku] = true
+	} else {
+		im.unreadable++
+	}
+	im.mu.Unlock()
+	im.fail(models.ImportRow{SKU: sku}, line, err)
+}
+
+// inFeed сообщает, что строка была в фиде или ее SKU пришел в битом оффере.
+func (im *importer) inFeed(sku string, pharmacyId int) bool {
+	return im.seen[rowKey{sku, pharmacyId}] || im.seenSkus[sku]
+}
+
+// apply сверяет пачку с текущими строками product_pharmacy и обновляет изменившиеся одной транзакцией.
+func (im *importer) apply(ctx context.Context, batch []importRow) {
+	skus := make([]string, 0, len(batch))
+	seen := make(map[string]bool, len(batch))
+	for _, row := range batch {
+		if !seen[row.SKU] {
+			seen[row.SKU] = true
+			skus = append(skus, row.SKU)
+		}
+	}
+
+	// Строки фида отмечаются до чтения базы: строки пачки, которую не удалось прочитать,
+	// попадают в Failed, а не в отсутствующие, и полная сверка их не снимает.
+	im.mu.Lock()
+	for _, row := range batch {
+		im.seen[rowKey{row.SKU, row.PharmacyId}] = true
+	}
+	im.mu.Unlock()
+
+	existing, err := im.productRepository.ProductPharmaciesBySku(skus)
+	if err != nil {
+		for _, row := range batch {
+			im.fail(row.ImportRow, 0, err)
+		}
+		return
+	}
+	current := make(map[rowKey]models.ProductPharmacy, len(existing))
+	for _, productPharmacy := range existing {
+		current[rowKey{productPharmacy.Sku, productPharmacy.PharmacyId}] = productPharmacy
+	}
+
+	var changed, unknown []importRow
+	var changes []models.ImportChange
+	var held []models.PriceHold
+	var unchanged []models.ImportRow
+	// claimed строки фида, которые еще не закреплены за поставщиком и не обновляются.
+	var claimed []importRow
+	for _, row := range batch {
+		productPharmacy, ok := current[rowKey{row.SKU, row.PharmacyId}]
+		claim := ok && productPharmacy.Supplier.String != im.supplier
+		switch {
+		case !ok:
+			unknown = append(unknown, row)
+		case productPharmacy.Price == row.Price && productPharmacy.Count == row.Count && !productPharmacy.IsHidden &&
+			sameExpiration(productPharmacy.ExpirationDate, row.Expiration):
+			unchanged = append(unchanged, row.ImportRow)
+			if claim {
+				claimed = append(claimed, row)
+			}
+		default:
+			change := models.ImportChange{
+				ImportRow: row.ImportRow,
+				ProductId: productPharmacy.ProductId,
+				OldPrice:  productPharmacy.Price,
+				NewPrice:  row.Price,
+				OldCount:  productPharmacy.Count,
+				NewCount:  row.Count,
+				OldHidden: productPharmacy.IsHidden,
+				// Пустой срок в фиде сохраненный не затирает.
+				OldExpiration: productPharmacy.ExpirationDate,
+				NewExpiration: productPharmacy.ExpirationDate,
+			}
+			if row.Expiration.Valid {
+				change.NewExpiration = row.Expiration
+			}
+			if reason := im.config.Guard.check(change); reason != "" {
+				held = append(held, models.PriceHold{ImportChange: change, Reason: reason})
+				if claim {
+					claimed = append(claimed, row)
+				}
+				continue
+			}
+			changed = append(changed, row)
+			changes = append(changes, change)
+		}
+	}
+
+	if (len(changed) > 0 || len(claimed) > 0) && !im.dryRun {
+		err = inTransaction(ctx, im.dblayer, func(ctx context.Context, tx *sqlx.Tx) error {
+			if len(changed) > 0 {
+				if err := updateProductPharmacies(ctx, tx, im.supplier, changed); err != nil {
+					return err
+				}
+			}
+			return claimProductPharmacies(ctx, tx, im.supplier, claimed)
+		})
+	}
+
+	im.mu.Lock()
+	defer im.mu.Unlock()
+	im.result.Unchanged = append(im.result.Unchanged, unchanged...)
+	im.result.Held = append(im.result.Held, held...)
+	for _, row := range unknown {
+		im.result.Unknown = append(im.result.Unknown, row.ImportRow)
+	}
+	im.unknown = append(im.unknown, unknown...)
+	if err != nil {
+		if len(changed) == 0 {
+			log.Printf("Error claiming %d product pharmacies for %s: %v", len(claimed), im.supplier, err)
+		}
+		for _, row := range changed {
+			im.result.Failed = append(im.result.Failed, models.ImportFailure{ImportRow: row.ImportRow, Error: err.Error()})
+		}
+		return
+	}
+	for _, row := range changed {
+		im.result.Updated = append(im.result.Updated, row.ImportRow)
+	}
+	im.changes = append(im.changes, changes...)
+}
+
+// maxLockRetries сколько раз транзакция пачки повторяется после дедлока.
+const maxLockRetries = 3
+
+// inTransaction выполняет fn в транзакции и повторяет ее, если MySQL откатил ее из-за
+// дедлока или таймаута блокировки: воркеры применяют пачки параллельно, и пачки с общими
+// SKU могут блокировать одни и те же строки в разном порядке.
+func inTransaction(ctx context.Context, db *dblayer.DBLayer, fn func(ctx context.Context, tx *sqlx.Tx) error) error {
+	var err error
+	for attempt := 0; attempt <= maxLockRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(time.Duration(attempt) * 50 * time.Millisecond):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err = db.InTransaction(ctx, fn); !lockConflict(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// lockConflict сообщает, что транзакция откатилась из-за дедлока (1213) или
+// таймаута ожидания блокировки (1205) и ее можно повторить.
+func lockConflict(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && (mysqlErr.Number == 1213 || mysqlErr.Number == 1205)
+}
+
+// missing возвращает строки поставщика из product_pharmacy, которых не было в фиде.
+func (im *importer) missing(productPharmacies []models.ProductPharmacy) []models.ImportRow {
+	var rows []models.ImportRow
+	for _, productPharmacy := range productPharmacies {
+		if !im.inFeed(productPharmacy.Sku, productPharmacy.PharmacyId) {
+			rows = append(rows, models.ImportRow{SKU: productPharmacy.Sku, PharmacyId: productPharmacy.PharmacyId})
+		}
+	}
+	return rows
+}
+
+// withdraw снимает с продажи строки поставщика, которых не было в фиде: обнуляет остаток
+// или скрывает строку. Уже снятые строки не трогаются. Если таких строк больше
+// maxPercent процентов от видимых строк поставщика, ничего не меняется и возвращается ErrSyncThreshold.
+// Если в фиде были битые офферы без SKU, ничего не меняется и возвращается ErrSyncUnreadable.
+func (im *importer) withdraw(ctx context.Context, productPharmacies []models.ProductPharmacy, hide bool, maxPercent float64) error {
+	if im.unreadable > 0 {
+		return fmt.Errorf("%w: %d offers without SKU", ErrSyncUnreadable, im.unreadable)
+	}
+
+	var withdrawn []models.ProductPharmacy
+	// Скрытые строки уже сняты с продажи и не должны разбавлять порог.
+	visible := 0
+	for _, productPharmacy := range productPharmacies {
+		if productPharmacy.IsHidden {
+			continue
+		}
+		visible++
+		if im.inFeed(productPharmacy.Sku, productPharmacy.PharmacyId) {
+			continue
+		}
+		if !hide && productPharmacy.Count == 0 {
+			continue
+		}
+		withdrawn = append(withdrawn, productPharmacy)
+	}
+	if len(withdrawn) == 0 {
+		return nil
+	}
+
+	percent := float64(len(withdrawn)) / float64(visible) * 100
+	if percent > maxPercent {
+		return fmt.Errorf("%w: %d of %d rows (%.1f%%), limit %.1f%%",
+			ErrSyncThreshold, len(withdrawn), visible, percent, maxPercent)
+	}
+
+	for start := 0; start < len(withdrawn); start += im.config.BatchSize {
+		batch := withdrawn[start:min(start+im.config.BatchSize, len(withdrawn))]
+		err := inTransaction(ctx, im.dblayer, func(ctx context.Context, tx *sqlx.Tx) error {
+			return withdrawProductPharmacies(ctx, tx, batch, hide)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, productPharmacy := range batch {
+			row := models.ImportRow{SKU: productPharmacy.Sku, PharmacyId: productPharmacy.PharmacyId}
+			newCount := 0
+			if hide {
+				newCount = productPharmacy.Count
+			}
+			im.result.Withdrawn = append(im.result.Withdrawn, row)
+			im.changes = append(im.changes, models.ImportChange{
+				ImportRow: row,
+				ProductId: productPharmacy.ProductId,
+				OldPrice:  productPharmacy.Price,
+				NewPrice:  productPharmacy.Price,
+				OldCount:  productPharmacy.Count,
+				NewCount:  newCount,
+				NewHidden: hide,
+				// Снятие срок годности не меняет.
+				OldExpiration: productPharmacy.ExpirationDate,
+				NewExpiration: productPharmacy.ExpirationDate,
+			})
+		}
+	}
+	return nil
+}
+
+// withdrawProductPharmacies обнуляет остаток или скрывает строки одним запросом.
+func withdrawProductPharmacies(ctx context.Context, tx *sqlx.Tx, productPharmacies []models.ProductPharmacy, hide bool) error {
+	keys := make([]string, len(productPharmacies))
+	args := []interface{}{time.Now()}
+	for i, productPharmacy := range productPharmacies {
+		keys[i] = "(?, ?)"
+		args = append(args, productPharmacy.Sku, productPharmacy.PharmacyId)
+	}
+
+	set := "count = 0"
+	if hide {
+		set = "is_hidden = 1"
+	}
+	query := fmt.Sprintf(`
+		UPDATE product_pharmacy SET %s, updated_at = ?
+		WHERE (sku, pharmacy_id) IN (%s)
+	`, set, strings.Join(keys, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to withdraw product pharmacies: %w", err)
+	}
+	return nil
+}
+
+// sameExpiration сообщает, что срок годности из фида не меняет сохраненный.
+// Пустой срок в фиде сохраненный не затирает.
+func sameExpiration(current, feed sql.NullTime) bool {
+	return !feed.Valid || models.SameDate(current, feed)
+}
+
+// updateProductPharmacies обновляет цену, остаток и срок годности строк одним запросом,
+// возвращает в выдачу скрытые полной сверкой строки и закрепляет их за поставщиком.
+func updateProductPharmacies(ctx context.Context, tx *sqlx.Tx, supplier string, rows []importRow) error {
+	var priceCases, countCases, expirationCases, keys []string
+	var priceArgs, countArgs, expirationArgs, keyArgs []interface{}
+	for _, row := range rows {
+		priceCases = append(priceCases, "WHEN sku = ? AND pharmacy_id = ? THEN ?")
+		priceArgs = append(priceArgs, row.SKU, row.PharmacyId, row.Price)
+		countCases = append(countCases, "WHEN sku = ? AND pharmacy_id = ? THEN ?")
+		countArgs = append(countArgs, row.SKU, row.PharmacyId, row.Count)
+		if row.Expiration.Valid {
+			expirationCases = append(expirationCases, "WHEN sku = ? AND pharmacy_id = ? THEN ?")
+			expirationArgs = append(expirationArgs, row.SKU, row.PharmacyId, row.Expiration.Time.Format(time.DateOnly))
+		}
+		keys = append(keys, "(?, ?)")
+		keyArgs = append(keyArgs, row.SKU, row.PharmacyId)
+	}
+
+	// Строки без срока годности в фиде сохраняют прежний.
+	expiration := ""
+	if len(expirationCases) > 0 {
+		expiration = fmt.Sprintf("expiration_date = CASE %s ELSE expiration_date END,", strings.Join(expirationCases, " "))
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE product_pharmacy SET
+			price = CASE %s ELSE price END,
+			count = CASE %s ELSE count END,
+			%s
+			is_hidden = 0,
+			supplier = ?,
+			updated_at = ?
+		WHERE (sku, pharmacy_id) IN (%s)
+	`, strings.Join(priceCases, " "), strings.Join(countCases, " "), expiration, strings.Join(keys, ", "))
+
+	args := append(priceArgs, countArgs...)
+	args = append(args, expirationArgs...)
+	args = append(args, supplier, time.Now())
+	args = append(args, keyArgs...)
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update product pharmacies: %w", err)
+	}
+	return nil
+}
+
+// claimProductPharmacies закрепляет строки за поставщиком без изменения updated_at:
+// поставщик не попадает в индекс, и переиндексировать строки не нужно.
+func claimProductPharmacies(ctx context.Context, tx *sqlx.Tx, supplier string, rows []importRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	keys := make([]string, len(rows))
+	args := []interface{}{supplier}
+	for i, row := range rows {
+		keys[i] = "(?, ?)"
+		args = append(args, row.SKU, row.PharmacyId)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE product_pharmacy SET supplier = ?
+		WHERE (sku, pharmacy_id) IN (%s)
+	`, strings.Join(keys, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to claim product pharmacies: %w", err)
+	}
+	return nil
+}
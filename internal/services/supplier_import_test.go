@@ -0,0 +1,54 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestImporterWithdrawKeepsUnparsedSkus(t *testing.T) {
+	im := newImporter(nil, nil, "sadykhan", ImportConfig{}, false)
+	im.skip("A1", 12, errors.New(`invalid price "12,5,0"`))
+
+	productPharmacies := []models.ProductPharmacy{
+		{Sku: "A1", PharmacyId: 1, Price: 100, Count: 5},
+		{Sku: "A1", PharmacyId: 2, Price: 100, Count: 3},
+		{Sku: "C1", PharmacyId: 1, Price: 300, Count: 2},
+	}
+
+	want := []models.ImportRow{{SKU: "C1", PharmacyId: 1}}
+	if got := im.missing(productPharmacies); !reflect.DeepEqual(got, want) {
+		t.Errorf("missing() = %v, want %v", got, want)
+	}
+
+	// Порог меньше одной строки: сверка отказывается и сообщает, сколько строк сняла бы.
+	err := im.withdraw(context.Background(), productPharmacies, false, 10)
+	if !errors.Is(err, ErrSyncThreshold) || !strings.Contains(err.Error(), "1 of 3 rows") {
+		t.Errorf("withdraw() error = %v, want %v for 1 of 3 rows", err, ErrSyncThreshold)
+	}
+	if len(im.result.Withdrawn) != 0 {
+		t.Errorf("withdraw() withdrew %v", im.result.Withdrawn)
+	}
+
+	wantFailed := []models.ImportFailure{{ImportRow: models.ImportRow{SKU: "A1"}, Line: 12, Error: `invalid price "12,5,0"`}}
+	if !reflect.DeepEqual(im.result.Failed, wantFailed) {
+		t.Errorf("Failed = %v, want %v", im.result.Failed, wantFailed)
+	}
+}
+
+func TestImporterWithdrawSkipsUnreadableFeed(t *testing.T) {
+	im := newImporter(nil, nil, "sadykhan", ImportConfig{}, false)
+	im.skip("", 7, errors.New("offer without SKU"))
+
+	productPharmacies := []models.ProductPharmacy{{Sku: "B1", PharmacyId: 1, Price: 100, Count: 5}}
+	err := im.withdraw(context.Background(), productPharmacies, true, 100)
+	if !errors.Is(err, ErrSyncUnreadable) {
+		t.Errorf("withdraw() error = %v, want %v", err, ErrSyncUnreadable)
+	}
+	if len(im.result.Withdrawn) != 0 {
+		t.Errorf("withdraw() withdrew %v", im.result.Withdrawn)
+	}
+}
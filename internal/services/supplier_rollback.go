@@ -0,0 +1,216 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"aurma_product/internal/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"time"
+)
+
+// Rollback возвращает строкам product_pharmacy цены, остатки, видимость и сроки годности, которые были
+// до запуска id. Строки, измененные после запуска, пропускаются и попадают в Failed,
+// если не задан force. Запуск с такими строками не помечается откатанным, чтобы их можно
+// было вернуть повторным откатом с force. Откат записывается отдельным запуском, после
+// него продукты с восстановленными строками переиндексируются.
+func (s *supplierService) Rollback(ctx context.Context, id int, force bool) (models.ImportResult, error) {
+	target, err := s.Run(ctx, id, "")
+	if err != nil {
+		return models.ImportResult{}, err
+	}
+	switch {
+	case target.RolledBackBy.Valid:
+		return models.ImportResult{}, ErrImportRunRolledBack
+	case target.Status == models.ImportRunRunning:
+		return models.ImportResult{}, ErrImportRunRunning
+	}
+
+	run := models.ImportRun{
+		Supplier:  target.Supplier,
+		Source:    fmt.Sprintf("run #%d", target.Id),
+		FeedHash:  target.FeedHash,
+		Mode:      models.ImportModeRollback,
+		StartedAt: time.Now(),
+	}
+	run.Id, err = s.importRunRepository.Start(run)
+	if err != nil {
+		return models.ImportResult{}, fmt.Errorf("failed to start import run: %w", err)
+	}
+
+	result := models.ImportResult{RunId: run.Id}
+	var changes []models.ImportChange
+	skipped := 0
+	batchSize := s.importConfig.withDefaults().BatchSize
+	for start := 0; start < len(target.Changes) && err == nil; start += batchSize {
+		batch := target.Changes[start:min(start+batchSize, len(target.Changes))]
+		var batchResult models.ImportResult
+		var restored []models.ImportChange
+		var batchSkipped int
+		err = inTransaction(ctx, s.dblayer, func(ctx context.Context, tx *sqlx.Tx) error {
+			var err error
+			restored, batchSkipped, err = restoreProductPharmacies(ctx, tx, batch, force, &batchResult)
+			return err
+		})
+		if err == nil {
+			result.Updated = append(result.Updated, batchResult.Updated...)
+			result.Unchanged = append(result.Unchanged, batchResult.Unchanged...)
+			result.Failed = append(result.Failed, batchResult.Failed...)
+			changes = append(changes, restored...)
+			skipped += batchSkipped
+		}
+	}
+	// Уже восстановленные строки повторный откат сочтет неизмененными.
+	if err == nil && skipped == 0 {
+		err = rollbackError(s.importRunRepository.MarkRolledBack(target.Id, run.Id))
+	}
+
+	if historyErr := s.priceHistoryRepository.Record(models.PricePoints(changes)); historyErr != nil {
+		err = errors.Join(err, fmt.Errorf("failed to record price history: %w", historyErr))
+	}
+
+	run.Finish(result, err)
+	if finishErr := s.importRunRepository.Finish(run, changes, result.Failed); finishErr != nil {
+		err = errors.Join(err, fmt.Errorf("failed to record import run %d: %w", run.Id, finishErr))
+	}
+
+	if len(changes) > 0 {
+		productIds := make([]int, 0, len(changes))
+		seen := make(map[int]bool, len(changes))
+		for _, change := range changes {
+			if !seen[change.ProductId] {
+				seen[change.ProductId] = true
+				productIds = append(productIds, change.ProductId)
+			}
+		}
+		stats, reindexErr := s.productService.ReindexProducts(ctx, productIds)
+		if reindexErr == nil && stats.Failed > 0 {
+			reindexErr = fmt.Errorf("failed to index %d of %d products", stats.Failed, stats.Indexed+stats.Failed)
+		}
+		if reindexErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to reindex rolled back products: %w", reindexErr))
+		}
+	}
+	return result, err
+}
+
+// restoreProductPharmacies блокирует строки пачки и возвращает им значения до запуска.
+// Возвращает изменения, которые сделал откат, и число строк, пропущенных из-за изменений
+// после запуска.
+func restoreProductPharmacies(ctx context.Context, tx *sqlx.Tx, batch []models.ImportChange, force bool, result *models.ImportResult) ([]models.ImportChange, int, error) {
+	keys := make([]string, len(batch))
+	keyArgs := make([]interface{}, 0, len(batch)*2)
+	for i, change := range batch {
+		keys[i] = "(?, ?)"
+		keyArgs = append(keyArgs, change.SKU, change.PharmacyId)
+	}
+	var existing []models.ProductPharmacy
+	err := tx.SelectContext(ctx, &existing, `
+		SELECT product_id, sku, pharmacy_id, price, count, is_hidden, expiration_date
+		FROM product_pharmacy
+		WHERE (sku, pharmacy_id) IN (`+strings.Join(keys, ", ")+`)
+		FOR UPDATE
+	`, keyArgs...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to lock product pharmacies: %w", err)
+	}
+	current := make(map[rowKey]models.ProductPharmacy, len(existing))
+	for _, productPharmacy := range existing {
+		current[rowKey{productPharmacy.Sku, productPharmacy.PharmacyId}] = productPharmacy
+	}
+
+	var restored []models.ImportChange
+	var failed []models.ImportFailure
+	var unchanged []models.ImportRow
+	skipped := 0
+	for _, change := range batch {
+		productPharmacy, ok := current[rowKey{change.SKU, change.PharmacyId}]
+		tracked := change.ExpirationTracked()
+		switch {
+		case !ok:
+			failed = append(failed, models.ImportFailure{ImportRow: change.ImportRow, Error: "row no longer exists"})
+		case productPharmacy.Price == change.OldPrice && productPharmacy.Count == change.OldCount &&
+			productPharmacy.IsHidden == change.OldHidden &&
+			(!tracked || models.SameDate(productPharmacy.ExpirationDate, change.OldExpiration)):
+			unchanged = append(unchanged, change.ImportRow)
+		case !force && (productPharmacy.Price != change.NewPrice || productPharmacy.Count != change.NewCount ||
+			productPharmacy.IsHidden != change.NewHidden ||
+			(tracked && !models.SameDate(productPharmacy.ExpirationDate, change.NewExpiration))):
+			failed = append(failed, models.ImportFailure{ImportRow: change.ImportRow, Error: fmt.Sprintf(
+				"changed after the run: price %d, count %d", productPharmacy.Price, productPharmacy.Count)})
+			skipped++
+		default:
+			restoredChange := models.ImportChange{
+				ImportRow:     change.ImportRow,
+				ProductId:     productPharmacy.ProductId,
+				OldPrice:      productPharmacy.Price,
+				NewPrice:      change.OldPrice,
+				OldCount:      productPharmacy.Count,
+				NewCount:      change.OldCount,
+				OldHidden:     productPharmacy.IsHidden,
+				NewHidden:     change.OldHidden,
+				OldExpiration: productPharmacy.ExpirationDate,
+				NewExpiration: productPharmacy.ExpirationDate,
+			}
+			if tracked {
+				restoredChange.NewExpiration = change.OldExpiration
+			}
+			restored = append(restored, restoredChange)
+		}
+	}
+
+	if len(restored) > 0 {
+		var priceCases, countCases, hiddenCases, expirationCases, restoredKeys []string
+		var priceArgs, countArgs, hiddenArgs, expirationArgs, restoredArgs []interface{}
+		for _, change := range restored {
+			priceCases = append(priceCases, "WHEN sku = ? AND pharmacy_id = ? THEN ?")
+			priceArgs = append(priceArgs, change.SKU, change.PharmacyId, change.NewPrice)
+			countCases = append(countCases, "WHEN sku = ? AND pharmacy_id = ? THEN ?")
+			countArgs = append(countArgs, change.SKU, change.PharmacyId, change.NewCount)
+			hiddenCases = append(hiddenCases, "WHEN sku = ? AND pharmacy_id = ? THEN ?")
+			hiddenArgs = append(hiddenArgs, change.SKU, change.PharmacyId, change.NewHidden)
+			expirationCases = append(expirationCases, "WHEN sku = ? AND pharmacy_id = ? THEN ?")
+			expirationArgs = append(expirationArgs, change.SKU, change.PharmacyId, change.NewExpiration)
+			restoredKeys = append(restoredKeys, "(?, ?)")
+			restoredArgs = append(restoredArgs, change.SKU, change.PharmacyId)
+		}
+
+		query := fmt.Sprintf(`
+			UPDATE product_pharmacy SET
+				price = CASE %s ELSE price END,
+				count = CASE %s ELSE count END,
+				is_hidden = CASE %s ELSE is_hidden END,
+				expiration_date = CASE %s ELSE expiration_date END,
+				updated_at = ?
+			WHERE (sku, pharmacy_id) IN (%s)
+		`, strings.Join(priceCases, " "), strings.Join(countCases, " "), strings.Join(hiddenCases, " "),
+			strings.Join(expirationCases, " "), strings.Join(restoredKeys, ", "))
+
+		args := append(priceArgs, countArgs...)
+		args = append(args, hiddenArgs...)
+		args = append(args, expirationArgs...)
+		args = append(args, time.Now())
+		args = append(args, restoredArgs...)
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return nil, 0, fmt.Errorf("failed to restore product pharmacies: %w", err)
+		}
+	}
+
+	for _, change := range restored {
+		result.Updated = append(result.Updated, change.ImportRow)
+	}
+	result.Unchanged = append(result.Unchanged, unchanged...)
+	result.Failed = append(result.Failed, failed...)
+	return restored, skipped, nil
+}
+
+// rollbackError переводит ошибки репозитория истории импортов в ошибки сервиса.
+func rollbackError(err error) error {
+	if errors.Is(err, repositories.ErrImportRunRolledBack) {
+		return ErrImportRunRolledBack
+	}
+	return err
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"aurma_product/internal/models"
+	"aurma_product/internal/repositories"
+	"aurma_product/internal/suppliers"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/antibomberman/dblayer"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+type supplierService struct {
+	productRepository      repositories.ProductRepository
+	supplierRepository     repositories.SupplierRepository
+	importRunRepository    repositories.ImportRunRepository
+	priceHoldRepository    repositories.PriceHoldRepository
+	priceHistoryRepository repositories.PriceHistoryRepository
+	dblayer                *dblayer.DBLayer
+	productService         ProductService
+	matchService           MatchService
+	registry               *suppliers.Registry
+	importConfig           ImportConfig
+}
+
+func NewSupplierService(dblayer *dblayer.DBLayer, productRepo repositories.ProductRepository, supplierRepo repositories.SupplierRepository, importRunRepo repositories.ImportRunRepository, priceHoldRepo repositories.PriceHoldRepository, priceHistoryRepo repositories.PriceHistoryRepository, productService ProductService, matchService MatchService, registry *suppliers.Registry, importConfig ImportConfig) SupplierService {
+	return &supplierService{
+		productRepository:      productRepo,
+		supplierRepository:     supplierRepo,
+		importRunRepository:    importRunRepo,
+		priceHoldRepository:    priceHoldRepo,
+		priceHistoryRepository: priceHistoryRepo,
+		dblayer:                dblayer,
+		productService:         productService,
+		matchService:           matchService,
+		registry:               registry,
+		importConfig:           importConfig,
+	}
+}
+
+// Suppliers возвращает имена зарегистрированных поставщиков.
+func (s *supplierService) Suppliers() []string {
+	return s.registry.Names()
+}
+
+// Stores возвращает привязки складов поставщика к аптекам, отсортированные по складу.
+func (s *supplierService) Stores(ctx context.Context, supplier string) ([]models.SupplierStore, error) {
+	if _, err := s.registry.Get(supplier); err != nil {
+		return nil, err
+	}
+	stores, err := s.supplierRepository.Stores(supplier)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.SupplierStore, 0, len(stores))
+	for _, store := range stores {
+		result = append(result, store)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StoreId < result[j].StoreId
+	})
+	return result, nil
+}
+
+// SetStore привязывает склад поставщика к аптеке. Пустой storeId соответствует
+// прайс-листам без колонки склада.
+func (s *supplierService) SetStore(ctx context.Context, supplier, storeId string, pharmacyId int) error {
+	if _, err := s.registry.Get(supplier); err != nil {
+		return err
+	}
+	return s.supplierRepository.SetStore(supplier, storeId, pharmacyId)
+}
+
+// DeleteStore удаляет привязку склада поставщика.
+func (s *supplierService) DeleteStore(ctx context.Context, supplier, storeId string) error {
+	err := s.supplierRepository.DeleteStore(supplier, storeId)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return ErrStoreNotFound
+	}
+	return err
+}
+
+// Import потоково разбирает фид поставщика и применяет офферы. Остаток каждого склада
+// пишется в строку product_pharmacy его аптеки, цена берется для города этой аптеки.
+// Битые офферы и неизвестные склады попадают в Failed итога, офферы с неизвестными
+// SKU ставятся в очередь сопоставления. source (файл или URL фида) пишется в историю импортов.
+func (s *supplierService) Import(ctx context.Context, supplier, source string, data io.Reader, contentType string) (models.ImportResult, error) {
+	return s.run(ctx, supplier, source, models.ImportModeImport, data, contentType, func(im *importer) error {
+		return s.enqueueUnknown(ctx, supplier, im)
+	})
+}
+
+// Sync применяет полный фид поставщика как Import, а затем снимает с продажи строки
+// поставщика, которых в фиде не было. Если фид прочитан не до конца, в нем есть офферы
+// без SKU или отсутствующих строк больше порога, сверка не выполняется: обрезанный
+// или битый фид не должен обнулить ассортимент.
+func (s *supplierService) Sync(ctx context.Context, supplier, source string, data io.Reader, contentType string, options models.SyncOptions) (models.ImportResult, error) {
+	return s.run(ctx, supplier, source, models.ImportModeSync, data, contentType, func(im *importer) error {
+		if err := s.enqueueUnknown(ctx, supplier, im); err != nil {
+			return err
+		}
+
+		productPharmacies, err := s.supplierRepository.ProductPharmacies(supplier, false)
+		if err != nil {
+			return fmt.Errorf("failed to get supplier product pharmacies: %w", err)
+		}
+		maxPercent := options.MaxWithdrawPercent
+		if maxPercent <= 0 {
+			maxPercent = im.config.MaxWithdrawPercent
+		}
+		return im.withdraw(ctx, productPharmacies, options.Hide, maxPercent)
+	})
+}
+
+// run применяет фид, после успешного применения вызывает finish и записывает запуск
+// с его изменениями и ошибками строк в историю импортов.
+func (s *supplierService) run(ctx context.Context, supplier, source, mode string, data io.Reader, contentType string, finish func(im *importer) error) (models.ImportResult, error) {
+	if _, err := s.registry.Get(supplier); err != nil {
+		return models.ImportResult{}, err
+	}
+	run := models.ImportRun{Supplier: supplier, Source: source, Mode: mode, StartedAt: time.Now()}
+	id, err := s.importRunRepository.Start(run)
+	if err != nil {
+		return models.ImportResult{}, fmt.Errorf("failed to start import run: %w", err)
+	}
+	run.Id = id
+
+	hash := sha256.New()
+	im, err := s.apply(ctx, supplier, io.TeeReader(data, hash), contentType, false)
+	if im != nil && len(im.result.Held) > 0 {
+		for i := range im.result.Held {
+			im.result.Held[i].Supplier = supplier
+			im.result.Held[i].RunId = sql.NullInt64{Int64: int64(run.Id), Valid: true}
+		}
+		if holdErr := s.priceHoldRepository.Hold(im.result.Held); holdErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to hold suspicious changes: %w", holdErr))
+		}
+	}
+	if err == nil {
+		// Разбор может не дочитать хвост фида, а хеш считается по нему целиком.
+		if _, err = io.Copy(hash, data); err != nil {
+			err = fmt.Errorf("error reading feed: %w", err)
+		}
+	}
+	if err == nil {
+		err = finish(im)
+	}
+
+	var result models.ImportResult
+	var changes []models.ImportChange
+	if im != nil {
+		result, changes = im.result, im.changes
+	}
+	if historyErr := s.priceHistoryRepository.Record(models.PricePoints(changes)); historyErr != nil {
+		err = errors.Join(err, fmt.Errorf("failed to record price history: %w", historyErr))
+	}
+	result.RunId = run.Id
+	run.FeedHash = hex.EncodeToString(hash.Sum(nil))
+	run.Finish(result, err)
+	if finishErr := s.importRunRepository.Finish(run, changes, result.Failed); finishErr != nil {
+		err = errors.Join(err, fmt.Errorf("failed to record import run %d: %w", run.Id, finishErr))
+	}
+	return result, err
+}
+
+// Runs возвращает историю запусков импорта поставщика, пустой supplier означает всех.
+func (s *supplierService) Runs(ctx context.Context, supplier string, limit, offset int) ([]models.ImportRun, error) {
+	return s.importRunRepository.Runs(supplier, limit, offset)
+}
+
+// Run возвращает запуск импорта с изменениями и ошибками строк, при непустом sku только по нему.
+func (s *supplierService) Run(ctx context.Context, id int, sku string) (models.ImportRun, error) {
+	run, err := s.importRunRepository.Get(id, sku)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return models.ImportRun{}, ErrImportRunNotFound
+		}
+		return models.ImportRun{}, err
+	}
+	return run, nil
+}
+
+// enqueueUnknown ставит офферы с неизвестными SKU в очередь сопоставления.
+func (s *supplierService) enqueueUnknown(ctx context.Context, supplier string, im *importer) error {
+	matches := make([]models.SupplierMatch, len(im.unknown))
+	for i, row := range im.unknown {
+		matches[i] = models.SupplierMatch{
+			Supplier:   supplier,
+			Sku:        row.SKU,
+			StoreId:    row.StoreId,
+			PharmacyId: row.PharmacyId,
+			Title:      row.Title,
+			Brand:      row.Brand,
+			Barcodes:   strings.Join(row.Barcodes, ","),
+			Price:      row.Price,
+			Count:      row.Count,
+		}
+	}
+	if err := s.matchService.Enqueue(ctx, matches); err != nil {
+		return fmt.Errorf("failed to queue unknown offers: %w", err)
+	}
+	return nil
+}
+
+// DryRun сверяет фид с текущими строками product_pharmacy поставщика, ничего не записывая.
+// Строки закрепляются за поставщиком только реальным импортом, поэтому в отсутствующие
+// попадают и незакрепленные строки его аптек: иначе сверка фида до первого импорта
+// всегда показывала бы пустой список.
+func (s *supplierService) DryRun(ctx context.Context, supplier string, data io.Reader, contentType string) (models.ImportDiff, error) {
+	im, err := s.apply(ctx, supplier, data, contentType, true)
+	if err != nil {
+		return models.ImportDiff{}, err
+	}
+
+	productPharmacies, err := s.supplierRepository.ProductPharmacies(supplier, true)
+	if err != nil {
+		return models.ImportDiff{}, fmt.Errorf("failed to get supplier product pharmacies: %w", err)
+	}
+	return models.NewImportDiff(im.changes, im.result, im.missing(productPharmacies)), nil
+}
+
+// apply прогоняет фид через importer. Возвращает nil, если импорт не начался.
+func (s *supplierService) apply(ctx context.Context, name string, data io.Reader, contentType string, dryRun bool) (*importer, error) {
+	supplier, err := s.registry.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	stores, err := s.supplierRepository.Stores(supplier.Name())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supplier stores: %w", err)
+	}
+	// Без привязок каждая строка фида упала бы с "unknown store". Сверка все равно
+	// проходит: ее отчет перечисляет склады фида, которые нужно привязать.
+	if len(stores) == 0 && !dryRun {
+		return nil, fmt.Errorf("%w %s: map feed stores to pharmacies with supplier-store-set", ErrNoStores, supplier.Name())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	offers, err := supplier.Stream(ctx, data, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	im := newImporter(s.dblayer, s.productRepository, supplier.Name(), s.importConfig, dryRun)
+	rows := make(chan importRow, im.config.BatchSize)
+
+	var streamErr error
+	go func() {
+		defer close(rows)
+		for item := range offers {
+			if item.Err != nil {
+				var parseErr *suppliers.ParseError
+				if errors.As(item.Err, &parseErr) {
+					im.skip(parseErr.SKU, parseErr.Line, parseErr.Err)
+					continue
+				}
+				streamErr = item.Err
+				return
+			}
+			for _, row := range offerRows(item.Offer, stores, im) {
+				rows <- row
+			}
+		}
+	}()
+
+	im.run(ctx, rows)
+	if streamErr != nil {
+		return im, fmt.Errorf("error reading offers: %w", streamErr)
+	}
+	return im, nil
+}
+
+// offerRows раскладывает оффер по складам поставщика.
+func offerRows(offer suppliers.Offer, stores map[string]models.SupplierStore, im *importer) []importRow {
+	if offer.SKU == "" {
+		im.skip("", offer.Line, fmt.Errorf("offer without SKU"))
+		return nil
+	}
+
+	rows := make([]importRow, 0, len(offer.Stocks))
+	for _, stock := range offer.Stocks {
+		row := models.ImportRow{SKU: offer.SKU, StoreId: stock.StoreId}
+		store, ok := stores[stock.StoreId]
+		if !ok {
+			im.fail(row, offer.Line, fmt.Errorf("unknown store %q, map it with supplier-store-set", stock.StoreId))
+			continue
+		}
+		row.PharmacyId = store.PharmacyId
+		price := offer.Price(int(store.CityId.Int64))
+		rows = append(rows, importRow{
+			ImportRow:  row,
+			Price:      price,
+			Count:      stock.Count,
+			Expiration: sql.NullTime{Time: offer.Expiration, Valid: !offer.Expiration.IsZero()},
+			Title:      offer.Title,
+			Brand:      offer.Brand,
+			Barcodes:   offer.Barcodes,
+		})
+	}
+	return rows
+}
@@ -0,0 +1,132 @@
+package suppliers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"golang.org/x/text/encoding/charmap"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// csvSampleSize сколько байт начала файла используется для определения кодировки и разделителя.
+const csvSampleSize = 64 * 1024
+
+// csvDelimiters разделители, из которых выбирается наиболее вероятный.
+var csvDelimiters = []rune{';', ',', '\t', '|'}
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// streamCSV читает CSV с определением кодировки, разделителя и строки заголовка.
+func streamCSV(ctx context.Context, data io.Reader, mapping Mapping, emit emitFunc) error {
+	buffered := bufio.NewReaderSize(data, csvSampleSize)
+	sample, err := buffered.Peek(csvSampleSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return fmt.Errorf("error reading CSV: %w", err)
+	}
+	if bytes.HasPrefix(sample, utf8BOM) {
+		if _, err := buffered.Discard(len(utf8BOM)); err != nil {
+			return fmt.Errorf("error reading CSV: %w", err)
+		}
+		sample = sample[len(utf8BOM):]
+	}
+
+	var reader io.Reader = buffered
+	switch encoding := strings.ToLower(mapping.Encoding); {
+	case encoding == "windows-1251" || encoding == "cp1251" || (encoding == "" && !isUTF8(sample)):
+		reader = charmap.Windows1251.NewDecoder().Reader(buffered)
+		if sample, err = charmap.Windows1251.NewDecoder().Bytes(sample); err != nil {
+			return fmt.Errorf("error decoding CSV: %w", err)
+		}
+	case encoding == "" || encoding == "utf-8" || encoding == "utf8":
+	default:
+		return fmt.Errorf("unsupported CSV encoding: %s", mapping.Encoding)
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+	if mapping.Delimiter != "" {
+		delimiter, _ := utf8.DecodeRuneInString(mapping.Delimiter)
+		if mapping.Delimiter == `\t` {
+			delimiter = '\t'
+		}
+		csvReader.Comma = delimiter
+	} else {
+		csvReader.Comma = detectDelimiter(string(sample))
+	}
+
+	return streamRows(ctx, &csvRows{reader: csvReader}, mapping, emit)
+}
+
+// isUTF8 проверяет образец на UTF-8, допуская обрезанный последний символ.
+func isUTF8(sample []byte) bool {
+	for cut := 0; cut < utf8.UTFMax && cut <= len(sample); cut++ {
+		if utf8.Valid(sample[:len(sample)-cut]) {
+			return true
+		}
+	}
+	return false
+}
+
+// detectDelimiter выбирает разделитель, который встречается одинаковое число раз
+// в наибольшем числе первых строк. Кавычки учитываются.
+func detectDelimiter(sample string) rune {
+	lines := strings.Split(sample, "\n")
+	if len(lines) > 1 {
+		// Последняя строка образца может быть обрезана.
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > 20 {
+		lines = lines[:20]
+	}
+
+	best, bestScore, bestCount := csvDelimiters[0], 0, 0
+	for _, delimiter := range csvDelimiters {
+		counts := make(map[int]int)
+		for _, line := range lines {
+			if count := countOutsideQuotes(line, delimiter); count > 0 {
+				counts[count]++
+			}
+		}
+		for count, lines := range counts {
+			if lines > bestScore || (lines == bestScore && count > bestCount) {
+				best, bestScore, bestCount = delimiter, lines, count
+			}
+		}
+	}
+	return best
+}
+
+func countOutsideQuotes(line string, delimiter rune) int {
+	count, quoted := 0, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == delimiter && !quoted:
+			count++
+		}
+	}
+	return count
+}
+
+type csvRows struct {
+	reader *csv.Reader
+}
+
+func (r *csvRows) Read() ([]string, int, int64, error) {
+	offset := r.reader.InputOffset()
+	cells, err := r.reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, 0, 0, err
+		}
+		return nil, 0, 0, fmt.Errorf("error parsing CSV: %w", err)
+	}
+	line, _ := r.reader.FieldPos(0)
+	return cells, line, offset, nil
+}
@@ -0,0 +1,49 @@
+package suppliers
+
+import "testing"
+
+func TestDetectDelimiter(t *testing.T) {
+	tests := []struct {
+		name   string
+		sample string
+		want   rune
+	}{
+		{name: "semicolon", sample: "sku;price;stock\n1;100;5\n2;200;6\n", want: ';'},
+		{name: "comma", sample: "sku,price,stock\n1,100,5\n2,200,6\n", want: ','},
+		{name: "tab", sample: "sku\tprice\tstock\n1\t100\t5\n", want: '\t'},
+		{name: "pipe", sample: "sku|price|stock\n1|100|5\n", want: '|'},
+		{name: "decimal commas", sample: "sku;price;stock\n1;100,50;5\n2;200,25;6\n", want: ';'},
+		{name: "quoted delimiters", sample: "sku,title,price\n1,\"Аспирин; 10 шт\",100\n2,\"Но-шпа; 20 шт\",200\n", want: ','},
+		{name: "truncated last line", sample: "sku;price\n1;100\n2;200\n3,4,5,6,7", want: ';'},
+		{name: "single line", sample: "sku,price,stock", want: ','},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectDelimiter(tt.sample); got != tt.want {
+				t.Errorf("detectDelimiter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsUTF8(t *testing.T) {
+	utf8Text := []byte("артикул;цена\n")
+	tests := []struct {
+		name   string
+		sample []byte
+		want   bool
+	}{
+		{name: "ascii", sample: []byte("sku;price\n"), want: true},
+		{name: "cyrillic", sample: utf8Text, want: true},
+		{name: "cut inside last rune", sample: utf8Text[:3], want: true},
+		{name: "windows-1251", sample: []byte{0xe0, 0xf0, 0xf2, 0xe8, 0xea, 0xf3, 0xeb}, want: false},
+		{name: "empty", sample: nil, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isUTF8(tt.sample); got != tt.want {
+				t.Errorf("isUTF8(%v) = %v, want %v", tt.sample, got, tt.want)
+			}
+		})
+	}
+}
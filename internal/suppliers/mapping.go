@@ -0,0 +1,265 @@
+package suppliers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Mapping разметка полей оффера в записи фида одного формата.
+// Пути задаются именами узлов через "/", "@name" читает атрибут.
+// В CSV и XLSX запись это строка таблицы, а поля задаются заголовком колонки
+// или ее номером вида "#1".
+type Mapping struct {
+	// Record путь к повторяющейся записи оффера от корня фида.
+	Record  string `json:"record"`
+	SKU     string `json:"sku"`
+	Title   string `json:"title"`
+	Brand   string `json:"brand"`
+	Barcode string `json:"barcode"`
+	// Expiration срок годности в формате 2006-01-02, 02.01.2006, RFC3339 или числом дней Excel.
+	Expiration string       `json:"expiration"`
+	Price      PriceMapping `json:"price"`
+	Stock      StockMapping `json:"stock"`
+
+	// Delimiter разделитель CSV, по умолчанию определяется по содержимому.
+	Delimiter string `json:"delimiter"`
+	// Encoding кодировка CSV: utf-8 или windows-1251, по умолчанию определяется по содержимому.
+	Encoding string `json:"encoding"`
+	// HeaderRow номер строки заголовка (с 1), по умолчанию ищется по колонкам разметки.
+	HeaderRow int `json:"header_row"`
+	// Sheet лист XLSX, по умолчанию первый.
+	Sheet string `json:"sheet"`
+}
+
+// PriceMapping разметка цен. Без City цена считается общей для всех городов.
+type PriceMapping struct {
+	Path  string `json:"path"`
+	City  string `json:"city"`
+	Value string `json:"value"`
+}
+
+// StockMapping разметка остатков. Если задан InStock, остаток учитывается только
+// при значении InStockValue (без учета регистра).
+type StockMapping struct {
+	Path         string `json:"path"`
+	Store        string `json:"store"`
+	Value        string `json:"value"`
+	InStock      string `json:"in_stock"`
+	InStockValue string `json:"in_stock_value"`
+}
+
+// Adapter дорабатывает оффер после разметки, когда формат поставщика не укладывается в Mapping.
+type Adapter func(record *Node, offer *Offer) error
+
+// FeedSupplier поставщик, фид которого описан разметкой по форматам.
+type FeedSupplier struct {
+	SupplierName string             `json:"name"`
+	Mappings     map[string]Mapping `json:"mappings"`
+	Adapter      Adapter            `json:"-"`
+}
+
+func (s *FeedSupplier) Name() string {
+	return s.SupplierName
+}
+
+// Stream разбирает фид по разметке его формата.
+func (s *FeedSupplier) Stream(ctx context.Context, data io.Reader, contentType string) (<-chan Item, error) {
+	contentType = strings.ToLower(contentType)
+	if contentType == "" {
+		return nil, fmt.Errorf("content type is empty")
+	}
+	mapping, ok := s.Mappings[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s does not support %s", ErrUnsupportedFormat, s.SupplierName, contentType)
+	}
+	stream, ok := formats[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, contentType)
+	}
+	if tabularFormats[contentType] {
+		mapping = mapping.columns()
+	}
+
+	items := make(chan Item, streamBuffer)
+	go func() {
+		defer close(items)
+		err := stream(ctx, data, mapping, func(record *Node, line int, offset int64, err error) error {
+			var item Item
+			if err == nil {
+				item.Offer, err = s.offer(mapping, record)
+				item.Offer.Line = line
+			} else if record != nil {
+				item.Offer.SKU = record.Value(mapping.SKU)
+			}
+			if err != nil {
+				item = Item{Err: &ParseError{Line: line, Offset: offset, SKU: item.Offer.SKU, Err: err}}
+			}
+			select {
+			case items <- item:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			select {
+			case items <- Item{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+	return items, nil
+}
+
+func (s *FeedSupplier) offer(mapping Mapping, record *Node) (Offer, error) {
+	offer := Offer{SKU: record.Value(mapping.SKU), Prices: make(map[int]int)}
+	if mapping.Title != "" {
+		offer.Title = record.Value(mapping.Title)
+	}
+	if mapping.Brand != "" {
+		offer.Brand = record.Value(mapping.Brand)
+	}
+	if mapping.Barcode != "" {
+		for _, barcode := range record.Values(mapping.Barcode) {
+			if barcode != "" {
+				offer.Barcodes = append(offer.Barcodes, barcode)
+			}
+		}
+	}
+
+	if mapping.Expiration != "" {
+		if value := strings.TrimSpace(record.Value(mapping.Expiration)); value != "" {
+			expiration, err := parseDate(value)
+			if err != nil {
+				return offer, fmt.Errorf("invalid expiration date %q: %w", value, err)
+			}
+			offer.Expiration = expiration
+		}
+	}
+
+	if mapping.Price.Value != "" {
+		for _, node := range record.Find(mapping.Price.Path) {
+			value := node.Value(mapping.Price.Value)
+			if value == "" {
+				continue
+			}
+			price, err := parseNumber(value)
+			if err != nil {
+				return offer, fmt.Errorf("invalid price %q: %w", value, err)
+			}
+			city := 0
+			if mapping.Price.City != "" {
+				city, err = strconv.Atoi(node.Value(mapping.Price.City))
+				if err != nil {
+					return offer, fmt.Errorf("invalid city: %w", err)
+				}
+			}
+			offer.Prices[city] = price
+		}
+	}
+
+	if mapping.Stock.Value != "" {
+		for _, node := range record.Find(mapping.Stock.Path) {
+			value := node.Value(mapping.Stock.Value)
+			count := 0
+			if value != "" {
+				var err error
+				count, err = parseNumber(value)
+				if err != nil {
+					return offer, fmt.Errorf("invalid stock %q: %w", value, err)
+				}
+			}
+			if mapping.Stock.InStock != "" && !strings.EqualFold(node.Value(mapping.Stock.InStock), mapping.Stock.InStockValue) {
+				count = 0
+			}
+			store := ""
+			if mapping.Stock.Store != "" {
+				store = node.Value(mapping.Stock.Store)
+			}
+			offer.Stocks = append(offer.Stocks, Stock{StoreId: store, Count: count})
+		}
+	}
+
+	if s.Adapter != nil {
+		if err := s.Adapter(record, &offer); err != nil {
+			return offer, err
+		}
+	}
+	return offer, nil
+}
+
+// parseNumber разбирает целое или дробное число, дробное округляется.
+// Пробелы между разрядами и запятая в дробной части допускаются.
+func parseNumber(value string) (int, error) {
+	value = strings.NewReplacer(" ", "", "\u00a0", "", ",", ".").Replace(strings.TrimSpace(value))
+	if n, err := strconv.Atoi(value); err == nil {
+		return n, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Round(f)), nil
+}
+
+// dateLayouts форматы сроков годности в фидах.
+var dateLayouts = []string{time.DateOnly, "02.01.2006", time.RFC3339, time.DateTime}
+
+// Excel хранит даты числом дней от 30.12.1899, XLSX без стиля даты отдает это число.
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// excelMaxSerial последний день, который Excel умеет хранить: 31.12.9999.
+const excelMaxSerial = 2958465
+
+// parseDate разбирает срок годности и отбрасывает время. Число считается датой Excel.
+func parseDate(value string) (time.Time, error) {
+	if serial, err := strconv.ParseFloat(value, 64); err == nil {
+		if serial < 1 || serial > excelMaxSerial {
+			return time.Time{}, fmt.Errorf("date serial %s out of range", value)
+		}
+		return excelEpoch.AddDate(0, 0, int(serial)), nil
+	}
+
+	var err error
+	for _, layout := range dateLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			year, month, day := t.Date()
+			return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// LoadFile читает разметку поставщиков из JSON-файла со списком FeedSupplier.
+func LoadFile(path string) ([]*FeedSupplier, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read suppliers file: %w", err)
+	}
+	var suppliers []*FeedSupplier
+	if err := json.Unmarshal(content, &suppliers); err != nil {
+		return nil, fmt.Errorf("failed to parse suppliers file: %w", err)
+	}
+	for _, supplier := range suppliers {
+		if supplier.SupplierName == "" {
+			return nil, fmt.Errorf("supplier without name in %s", path)
+		}
+		for format, mapping := range supplier.Mappings {
+			if !tabularFormats[format] {
+				continue
+			}
+			if err := mapping.checkColumns(); err != nil {
+				return nil, fmt.Errorf("supplier %s, %s mapping: %w", supplier.SupplierName, format, err)
+			}
+		}
+	}
+	return suppliers, nil
+}
@@ -0,0 +1,108 @@
+package suppliers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{value: "120", want: 120},
+		{value: " 120 ", want: 120},
+		{value: "1 250", want: 1250},
+		{value: "1\u00a0250", want: 1250},
+		{value: "99.5", want: 100},
+		{value: "99,4", want: 99},
+		{value: "1 250,50", want: 1251},
+		{value: "-3", want: -3},
+		{value: "", wantErr: true},
+		{value: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseNumber(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseNumber(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseNumber(%q) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "2025-03-01", want: "2025-03-01"},
+		{value: "01.03.2025", want: "2025-03-01"},
+		{value: "2025-03-01T23:30:00+06:00", want: "2025-03-01"},
+		{value: "2025-03-01 10:00:00", want: "2025-03-01"},
+		{value: "45678", want: "2025-01-21"},
+		{value: "45678.75", want: "2025-01-21"},
+		{value: "1", want: "1899-12-31"},
+		{value: "0", wantErr: true},
+		{value: "20250301", wantErr: true},
+		{value: "03/01/2025", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseDate(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseDate(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got.Format(time.DateOnly) != tt.want || got.Location() != time.UTC || got.Hour() != 0 {
+			t.Errorf("parseDate(%q) = %v, want %s UTC midnight", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "csv with stock column",
+			content: `[{"name": "apteka", "mappings": {"csv": {"sku": "Артикул", "price": {"value": "Цена"}, "stock": {"value": "Остаток"}}}}]`,
+		},
+		{
+			name:    "price only csv",
+			content: `[{"name": "apteka", "mappings": {"csv": {"sku": "Артикул", "price": {"value": "Цена"}}}}]`,
+			wantErr: "supplier apteka, csv mapping: stock column is not mapped",
+		},
+		{
+			name:    "xml without stock",
+			content: `[{"name": "apteka", "mappings": {"xml": {"record": "offers/offer", "sku": "@sku", "price": {"value": "price"}}}}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "suppliers.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadFile(path)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("LoadFile() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("LoadFile() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
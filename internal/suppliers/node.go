@@ -0,0 +1,148 @@
+package suppliers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Node запись фида в виде дерева, общем для XML, JSON и табличных форматов.
+type Node struct {
+	Name     string
+	Attrs    map[string]string
+	Text     string
+	Children []*Node
+}
+
+// UnmarshalXML собирает дерево элемента XML.
+func (n *Node) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	n.Name = start.Name.Local
+	if len(start.Attr) > 0 {
+		n.Attrs = make(map[string]string, len(start.Attr))
+		for _, attr := range start.Attr {
+			n.Attrs[attr.Name.Local] = attr.Value
+		}
+	}
+
+	var text strings.Builder
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			child := &Node{}
+			if err := child.UnmarshalXML(d, t); err != nil {
+				return err
+			}
+			n.Children = append(n.Children, child)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			n.Text = strings.TrimSpace(text.String())
+			return nil
+		}
+	}
+}
+
+// nodeFromJSON собирает дерево из значения JSON. Элементы массива становятся
+// соседними узлами с именем ключа массива.
+func nodeFromJSON(name string, value interface{}) *Node {
+	node := &Node{Name: name}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if items, ok := v[key].([]interface{}); ok {
+				for _, item := range items {
+					node.Children = append(node.Children, nodeFromJSON(key, item))
+				}
+				continue
+			}
+			node.Children = append(node.Children, nodeFromJSON(key, v[key]))
+		}
+	case []interface{}:
+		for _, item := range v {
+			node.Children = append(node.Children, nodeFromJSON(name, item))
+		}
+	case json.Number:
+		node.Text = v.String()
+	case string:
+		node.Text = strings.TrimSpace(v)
+	case nil:
+	default:
+		node.Text = fmt.Sprint(v)
+	}
+	return node
+}
+
+// Find возвращает узлы по пути из имен, разделенных "/". Пустой путь или "." означает сам узел.
+func (n *Node) Find(path string) []*Node {
+	nodes := []*Node{n}
+	for _, segment := range splitPath(path) {
+		var next []*Node
+		for _, node := range nodes {
+			for _, child := range node.Children {
+				if child.Name == segment {
+					next = append(next, child)
+				}
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// Values возвращает значения по пути. Последний сегмент вида "@name" читает атрибут,
+// а если атрибута нет, дочерний узел с этим именем.
+func (n *Node) Values(path string) []string {
+	segments := splitPath(path)
+	if len(segments) == 0 || !strings.HasPrefix(segments[len(segments)-1], "@") {
+		var values []string
+		for _, node := range n.Find(path) {
+			values = append(values, node.Text)
+		}
+		return values
+	}
+
+	name := strings.TrimPrefix(segments[len(segments)-1], "@")
+	var values []string
+	for _, node := range n.Find(strings.Join(segments[:len(segments)-1], "/")) {
+		if value, ok := node.Attrs[name]; ok {
+			values = append(values, value)
+			continue
+		}
+		for _, child := range node.Children {
+			if child.Name == name {
+				values = append(values, child.Text)
+			}
+		}
+	}
+	return values
+}
+
+// Value возвращает первое значение по пути или пустую строку.
+func (n *Node) Value(path string) string {
+	values := n.Values(path)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func splitPath(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" && segment != "." {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
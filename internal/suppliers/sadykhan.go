@@ -0,0 +1,45 @@
+package suppliers
+
+// SadykhanName имя поставщика Садыхан в supplier_stores.
+const SadykhanName = "sadykhan"
+
+// NewSadykhan возвращает поставщика Садыхан с разметкой его XML (rocketpharm_catalog) и JSON фидов.
+func NewSadykhan() Supplier {
+	return &FeedSupplier{
+		SupplierName: SadykhanName,
+		Mappings: map[string]Mapping{
+			"xml": {
+				Record:     "rocketpharm_catalog/offers/offer",
+				SKU:        "@sku",
+				Title:      "model",
+				Brand:      "brand",
+				Barcode:    "barcodes/barcode",
+				Expiration: "expiration_date",
+				Price:      PriceMapping{Path: "city_prices/city_price", City: "@city_id", Value: "."},
+				Stock: StockMapping{
+					Path:         "availabilities/availability",
+					Store:        "@storeId",
+					Value:        ".",
+					InStock:      "@available",
+					InStockValue: "yes",
+				},
+			},
+			"json": {
+				Record:     "offers",
+				SKU:        "sku",
+				Title:      "model",
+				Brand:      "brand",
+				Barcode:    "barcodes/barcodes",
+				Expiration: "expiration_date",
+				Price:      PriceMapping{Path: "city_prices", City: "city_id", Value: "city_price"},
+				Stock: StockMapping{
+					Path:         "availabilities",
+					Store:        "store_id",
+					Value:        "availability",
+					InStock:      "available",
+					InStockValue: "yes",
+				},
+			},
+		},
+	}
+}
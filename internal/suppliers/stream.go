@@ -0,0 +1,550 @@
+package suppliers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// streamBuffer размер буфера канала офферов.
+const streamBuffer = 64
+
+// emitFunc получает очередную запись фида с ее строкой и смещением. Если запись не
+// разобралась, err содержит причину, а record то, что успели прочитать, или nil.
+type emitFunc func(record *Node, line int, offset int64, err error) error
+
+// streamFunc потоково читает записи фида по разметке.
+type streamFunc func(ctx context.Context, data io.Reader, mapping Mapping, emit emitFunc) error
+
+// formats потоковые читатели по формату фида.
+var formats = map[string]streamFunc{
+	"xml":  streamXML,
+	"json": streamJSON,
+	"csv":  streamCSV,
+	"xlsx": streamXLSX,
+}
+
+// streamXML отдает элементы, путь которых заканчивается на mapping.Record. Границы
+// записей ищутся по тегам без проверки разметки, а каждая запись разбирается отдельно,
+// поэтому синтаксическая ошибка внутри записи пропускает только ее.
+func streamXML(ctx context.Context, data io.Reader, mapping Mapping, emit emitFunc) error {
+	segments := splitPath(mapping.Record)
+	if len(segments) == 0 {
+		return fmt.Errorf("record path is empty")
+	}
+	name := segments[len(segments)-1]
+
+	lines := &lineTracker{r: data}
+	scanner := &xmlScanner{r: bufio.NewReader(lines)}
+	var stack, inner []string
+	// record открытая запись, байты которой копит сканер.
+	var record *xmlTag
+	emitRecord := func(start xmlTag, raw []byte) error {
+		node, err := decodeXMLRecord(raw)
+		return emit(node, lines.Line(start.offset), start.offset, err)
+	}
+	open := func(tag xmlTag) error {
+		if tag.empty {
+			return emitRecord(tag, scanner.release())
+		}
+		record, inner = &tag, inner[:0]
+		scanner.keep = true
+		return nil
+	}
+	closeRecord := func() error {
+		start := *record
+		record = nil
+		return emitRecord(start, scanner.release())
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		tag, err := scanner.next()
+		if err == io.EOF && record == nil && len(stack) == 0 {
+			return nil
+		}
+		if err == io.EOF {
+			err = fmt.Errorf("unexpected EOF")
+		}
+		if err != nil {
+			return fmt.Errorf("error parsing XML at line %d: %w", lines.Line(scanner.offset), err)
+		}
+
+		if record == nil {
+			switch {
+			case tag.kind == xmlDeclaration:
+				if err := tag.checkEncoding(); err != nil {
+					return fmt.Errorf("error parsing XML: %w", err)
+				}
+			case tag.kind == xmlStart && hasSuffix(append(stack, tag.name), segments):
+				if err := open(tag); err != nil {
+					return err
+				}
+			case tag.kind == xmlStart && !tag.empty:
+				stack = append(stack, tag.name)
+			case tag.kind == xmlEnd:
+				stack = closeTag(stack, tag.name)
+			}
+			continue
+		}
+
+		switch {
+		case tag.kind == xmlStart && tag.name == name:
+			// Запись не закрыта, а началась следующая: битая запись заканчивается перед ней.
+			raw := scanner.buf.Bytes()
+			next := append([]byte(nil), raw[tag.start:]...)
+			scanner.buf.Truncate(tag.start)
+			if err := closeRecord(); err != nil {
+				return err
+			}
+			scanner.buf.Reset()
+			scanner.buf.Write(next)
+			if err := open(tag); err != nil {
+				return err
+			}
+		case tag.kind == xmlStart && !tag.empty:
+			inner = append(inner, tag.name)
+		case tag.kind == xmlEnd && contains(inner, tag.name):
+			inner = closeTag(inner, tag.name)
+		case tag.kind == xmlEnd && tag.name == name:
+			if err := closeRecord(); err != nil {
+				return err
+			}
+		case tag.kind == xmlEnd && contains(stack, tag.name):
+			// Запись не закрыта до конца родителя.
+			if err := closeRecord(); err != nil {
+				return err
+			}
+			stack = closeTag(stack, tag.name)
+		}
+	}
+}
+
+// decodeXMLRecord разбирает одну запись. При ошибке возвращается и то, что успели прочитать:
+// имя, атрибуты и закрытые дочерние элементы.
+func decodeXMLRecord(raw []byte) (*Node, error) {
+	var node Node
+	if err := xml.NewDecoder(bytes.NewReader(raw)).Decode(&node); err != nil {
+		return &node, err
+	}
+	return &node, nil
+}
+
+func hasSuffix(stack, segments []string) bool {
+	if len(stack) < len(segments) {
+		return false
+	}
+	tail := stack[len(stack)-len(segments):]
+	for i := range segments {
+		if tail[i] != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(stack []string, name string) bool {
+	for _, item := range stack {
+		if item == name {
+			return true
+		}
+	}
+	return false
+}
+
+// closeTag снимает со стека элементы до последнего с именем name. Закрывающий тег
+// без открывающего игнорируется.
+func closeTag(stack []string, name string) []string {
+	for i := len(stack) - 1; i >= 0; i-- {
+		if stack[i] == name {
+			return stack[:i]
+		}
+	}
+	return stack
+}
+
+type xmlTagKind int
+
+const (
+	xmlStart xmlTagKind = iota
+	xmlEnd
+	xmlDeclaration
+	xmlOther
+)
+
+// xmlTag тег XML, найденный xmlScanner.
+type xmlTag struct {
+	kind  xmlTagKind
+	name  string
+	empty bool
+	// offset смещение "<" в фиде, start его позиция в буфере сканера.
+	offset int64
+	start  int
+	raw    []byte
+}
+
+var xmlEncoding = regexp.MustCompile(`encoding\s*=\s*["']([^"']*)["']`)
+
+// checkEncoding проверяет кодировку из объявления XML: записи разбираются как UTF-8.
+func (t xmlTag) checkEncoding() error {
+	match := xmlEncoding.FindSubmatch(t.raw)
+	if match == nil || strings.EqualFold(string(match[1]), "utf-8") {
+		return nil
+	}
+	return fmt.Errorf("unsupported encoding %q", match[1])
+}
+
+// xmlScanner делит XML на теги, не проверяя разметку. Байты текущего тега, а пока
+// keep установлен, и все следующие, копируются в buf.
+type xmlScanner struct {
+	r      *bufio.Reader
+	offset int64
+	buf    bytes.Buffer
+	keep   bool
+}
+
+func (s *xmlScanner) readByte() (byte, error) {
+	c, err := s.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	s.offset++
+	s.buf.WriteByte(c)
+	return c, nil
+}
+
+// release возвращает накопленные байты и перестает их копировать. Срез действует
+// до следующего вызова next.
+func (s *xmlScanner) release() []byte {
+	s.keep = false
+	return s.buf.Bytes()
+}
+
+// next пропускает текст и читает следующий тег, комментарий, CDATA или инструкцию.
+func (s *xmlScanner) next() (xmlTag, error) {
+	for {
+		if !s.keep {
+			s.buf.Reset()
+		}
+		c, err := s.readByte()
+		if err != nil {
+			return xmlTag{}, err
+		}
+		if c == '<' {
+			break
+		}
+	}
+	tag := xmlTag{kind: xmlOther, offset: s.offset - 1, start: s.buf.Len() - 1}
+
+	c, err := s.readByte()
+	if err != nil {
+		return tag, err
+	}
+	switch c {
+	case '?':
+		if err := s.skipTo("?>"); err != nil {
+			return tag, err
+		}
+		if raw := s.buf.Bytes()[tag.start:]; bytes.HasPrefix(raw, []byte("<?xml ")) {
+			tag.kind, tag.raw = xmlDeclaration, raw
+		}
+		return tag, nil
+	case '!':
+		return tag, s.skipMarkup()
+	case '/':
+		tag.kind = xmlEnd
+		var last byte
+		if tag.name, last, err = s.readName(0); err != nil || last == '>' {
+			return tag, err
+		}
+		return tag, s.skipTo(">")
+	}
+
+	tag.kind = xmlStart
+	name, last, err := s.readName(c)
+	if err != nil {
+		return tag, err
+	}
+	tag.name = name
+	var quote byte
+	for last != '>' || quote != 0 {
+		prev := last
+		if last, err = s.readByte(); err != nil {
+			return tag, err
+		}
+		switch {
+		case quote != 0 && last == quote:
+			quote = 0
+		case quote == 0 && (last == '"' || last == '\''):
+			quote = last
+		case quote == 0 && last == '>':
+			tag.empty = prev == '/'
+		}
+	}
+	if tag.name == "" {
+		tag.kind = xmlOther
+	}
+	return tag, nil
+}
+
+// readName читает имя тега до пробела, "/" или ">" и возвращает его без префикса
+// пространства имен вместе с байтом, на котором имя закончилось.
+func (s *xmlScanner) readName(first byte) (string, byte, error) {
+	var name []byte
+	c := first
+	for {
+		if c != 0 {
+			switch c {
+			case ' ', '\t', '\r', '\n', '/', '>':
+				if i := bytes.LastIndexByte(name, ':'); i >= 0 {
+					name = name[i+1:]
+				}
+				return string(name), c, nil
+			}
+			name = append(name, c)
+		}
+		var err error
+		if c, err = s.readByte(); err != nil {
+			return "", 0, err
+		}
+	}
+}
+
+// skipMarkup пропускает комментарий, CDATA или объявление DOCTYPE после "<!".
+func (s *xmlScanner) skipMarkup() error {
+	prefix, err := s.r.Peek(7)
+	switch {
+	case bytes.HasPrefix(prefix, []byte("--")):
+		return s.skipTo("-->")
+	case bytes.HasPrefix(prefix, []byte("[CDATA[")):
+		return s.skipTo("]]>")
+	case err != nil && err != io.EOF:
+		return err
+	}
+	depth := 0
+	for {
+		c, err := s.readByte()
+		if err != nil {
+			return err
+		}
+		switch {
+		case c == '[':
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+		case c == '>' && depth == 0:
+			return nil
+		}
+	}
+}
+
+// skipTo читает байты до конца последовательности end включительно.
+func (s *xmlScanner) skipTo(end string) error {
+	for {
+		if _, err := s.readByte(); err != nil {
+			return err
+		}
+		if bytes.HasSuffix(s.buf.Bytes(), []byte(end)) {
+			return nil
+		}
+	}
+}
+
+// streamJSON отдает элементы массива, лежащего по пути ключей mapping.Record. Элементы
+// выделяются по скобкам и разбираются по отдельности, поэтому синтаксическая ошибка
+// внутри элемента пропускает только его. После массива фид не читается.
+func streamJSON(ctx context.Context, data io.Reader, mapping Mapping, emit emitFunc) error {
+	segments := splitPath(mapping.Record)
+	lines := &lineTracker{r: data}
+	decoder := json.NewDecoder(lines)
+
+	found, err := findJSONArray(decoder, segments)
+	if err != nil || !found {
+		return err
+	}
+	name := ""
+	if len(segments) > 0 {
+		name = segments[len(segments)-1]
+	}
+	elements := &jsonElements{
+		r:      bufio.NewReader(io.MultiReader(decoder.Buffered(), lines)),
+		offset: decoder.InputOffset(),
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		raw, offset, err := elements.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error parsing JSON at line %d: %w", lines.Line(elements.offset), err)
+		}
+
+		var node *Node
+		value, err := decodeJSONValue(raw)
+		if err == nil {
+			node = nodeFromJSON(name, value)
+		}
+		if err := emit(node, lines.Line(offset), offset, err); err != nil {
+			return err
+		}
+	}
+}
+
+// findJSONArray спускается по ключам segments, пропуская остальные значения объектов,
+// и останавливается на открывающей скобке массива записей. Если ключа нет, возвращает false.
+func findJSONArray(decoder *json.Decoder, segments []string) (bool, error) {
+	for _, segment := range segments {
+		if err := expectDelim(decoder, '{'); err != nil {
+			return false, err
+		}
+		found := false
+		for !found && decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return false, fmt.Errorf("error parsing JSON: %w", err)
+			}
+			if key == segment {
+				found = true
+				continue
+			}
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return false, fmt.Errorf("error parsing JSON: %w", err)
+			}
+		}
+		if !found {
+			return false, expectDelim(decoder, '}')
+		}
+	}
+	return true, expectDelim(decoder, '[')
+}
+
+// decodeJSONValue разбирает одно значение и проверяет, что после него ничего нет.
+func decodeJSONValue(raw []byte) (interface{}, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("empty array element")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid data after value at offset %d", decoder.InputOffset())
+	}
+	return value, nil
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("error parsing JSON: %w", err)
+	}
+	if token != delim {
+		return fmt.Errorf("error parsing JSON: expected %q, got %v", delim, token)
+	}
+	return nil
+}
+
+// jsonElements делит массив JSON на сырые элементы по запятым вне строк и скобок.
+// Перевод строки закрывает строку: внутри строки JSON его быть не может, а так
+// незакрытая кавычка портит только свою строку фида.
+type jsonElements struct {
+	r      *bufio.Reader
+	offset int64
+	closed bool
+}
+
+// next возвращает следующий элемент и его смещение, io.EOF после конца массива.
+func (e *jsonElements) next() ([]byte, int64, error) {
+	if e.closed {
+		return nil, 0, io.EOF
+	}
+	var raw []byte
+	var start int64
+	var inString, escaped bool
+	depth := 0
+	for {
+		c, err := e.r.ReadByte()
+		if err == io.EOF {
+			return nil, 0, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		e.offset++
+
+		if len(raw) == 0 {
+			switch c {
+			case ' ', '\t', '\r', '\n':
+				continue
+			}
+			start = e.offset - 1
+		}
+		switch {
+		case inString && escaped:
+			escaped = false
+		case inString && c == '\\':
+			escaped = true
+		case inString && (c == '"' || c == '\n'):
+			inString = false
+		case inString:
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+		case (c == '}' || c == ']') && depth > 0:
+			depth--
+		case (c == ',' || c == ']') && depth == 0:
+			e.closed = c == ']'
+			if len(raw) == 0 && e.closed {
+				// Пустой массив или запятая перед "]".
+				return nil, 0, io.EOF
+			}
+			return bytes.TrimSpace(raw), start, nil
+		}
+		raw = append(raw, c)
+	}
+}
+
+// lineTracker считает переводы строк в прочитанных данных, чтобы по смещению
+// декодера найти номер строки. Хранятся только позиции впереди последнего запроса.
+type lineTracker struct {
+	r        io.Reader
+	read     int64
+	newlines []int64
+	passed   int
+}
+
+func (t *lineTracker) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	for i := 0; i < n; i++ {
+		if p[i] == '\n' {
+			t.newlines = append(t.newlines, t.read+int64(i))
+		}
+	}
+	t.read += int64(n)
+	return n, err
+}
+
+// Line возвращает номер строки (с 1) для смещения. Смещения должны запрашиваться по возрастанию.
+func (t *lineTracker) Line(offset int64) int {
+	i := 0
+	for i < len(t.newlines) && t.newlines[i] < offset {
+		i++
+	}
+	t.passed += i
+	t.newlines = t.newlines[i:]
+	return t.passed + 1
+}
@@ -0,0 +1,137 @@
+package suppliers
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// streamed запись, отданная потоковым читателем.
+type streamed struct {
+	SKU    string
+	Line   int
+	Offset int64
+	Failed bool
+}
+
+func collect(t *testing.T, stream streamFunc, feed string, mapping Mapping) []streamed {
+	t.Helper()
+	var got []streamed
+	err := stream(context.Background(), strings.NewReader(feed), mapping, func(record *Node, line int, offset int64, err error) error {
+		item := streamed{Line: line, Offset: offset, Failed: err != nil}
+		if record != nil {
+			item.SKU = record.Value(mapping.SKU)
+		}
+		got = append(got, item)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("stream error = %v", err)
+	}
+	return got
+}
+
+func TestStreamXML(t *testing.T) {
+	feed := `<?xml version="1.0" encoding="UTF-8"?>
+<catalog>
+<shop><offers>
+<offer sku="A1"><price>100</price></offer>
+<offer sku="B1"><price>1<2</price></offer>
+<offer sku="C1"><price>300</price>
+<offer sku="D1"><price>400 & 500</price></offer>
+<!-- <offer sku="X1"/> -->
+<offer sku="E1"><title><![CDATA[</offer>]]></title></offer>
+<offer sku="F1"/>
+</offers></shop>
+<gifts><offer sku="G1"/></gifts>
+</catalog>
+`
+	at := func(sku string) int64 {
+		return int64(strings.Index(feed, `<offer sku="`+sku+`"`))
+	}
+	want := []streamed{
+		{SKU: "A1", Line: 4, Offset: at("A1")},
+		{SKU: "B1", Line: 5, Offset: at("B1"), Failed: true},
+		{SKU: "C1", Line: 6, Offset: at("C1"), Failed: true},
+		{SKU: "D1", Line: 7, Offset: at("D1"), Failed: true},
+		{SKU: "E1", Line: 9, Offset: at("E1")},
+		{SKU: "F1", Line: 10, Offset: at("F1")},
+	}
+	got := collect(t, streamXML, feed, Mapping{Record: "offers/offer", SKU: "@sku"})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("streamXML() = %v, want %v", got, want)
+	}
+}
+
+func TestStreamXMLEncoding(t *testing.T) {
+	feed := `<?xml version="1.0" encoding="windows-1251"?><offers><offer sku="A1"/></offers>`
+	err := streamXML(context.Background(), strings.NewReader(feed), Mapping{Record: "offers/offer", SKU: "@sku"}, func(*Node, int, int64, error) error {
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "windows-1251") {
+		t.Errorf("streamXML() error = %v, want unsupported encoding", err)
+	}
+}
+
+func TestStreamJSON(t *testing.T) {
+	feed := `{
+  "shop": {"name": "Садыхан", "offers": [1, 2]},
+  "catalog": {
+    "meta": {"offers": [{"sku": "Z1"}]},
+    "offers": [
+      {"sku": "A1", "price": 100},
+      {"sku": "B1", "price": 1 00},
+      {
+        "sku": "C1",
+        "title": "broken,
+        "price": 300
+      },
+      {"sku": "D1", "price": 400},
+    ]
+  }
+}`
+	at := func(prefix string) int64 {
+		return int64(strings.Index(feed, prefix))
+	}
+	want := []streamed{
+		{SKU: "A1", Line: 6, Offset: at(`{"sku": "A1"`)},
+		{Line: 7, Offset: at(`{"sku": "B1"`), Failed: true},
+		{Line: 8, Offset: at("{\n        \"sku\": \"C1\""), Failed: true},
+		{SKU: "D1", Line: 13, Offset: at(`{"sku": "D1"`)},
+	}
+	got := collect(t, streamJSON, feed, Mapping{Record: "catalog/offers", SKU: "sku"})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("streamJSON() = %v, want %v", got, want)
+	}
+}
+
+func TestFeedSupplierStreamSkipsMalformedOffers(t *testing.T) {
+	feed := `<rocketpharm_catalog><offers>
+<offer sku="A1"><city_prices><city_price city_id="1">1<00</city_price></city_prices></offer>
+<offer sku="B1"><city_prices><city_price city_id="1">200</city_price></city_prices></offer>
+</offers></rocketpharm_catalog>`
+	items, err := NewSadykhan().Stream(context.Background(), strings.NewReader(feed), "xml")
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+
+	var skus []string
+	var parseErr *ParseError
+	for item := range items {
+		switch {
+		case errors.As(item.Err, &parseErr):
+			if parseErr.SKU != "A1" || parseErr.Line != 2 {
+				t.Errorf("ParseError = %v, want A1 at line 2", parseErr)
+			}
+		case item.Err != nil:
+			t.Fatalf("Stream() item error = %v", item.Err)
+		default:
+			skus = append(skus, item.Offer.SKU)
+		}
+	}
+	if parseErr == nil || !reflect.DeepEqual(skus, []string{"B1"}) {
+		t.Errorf("Stream() = %v, %v, want B1 after a ParseError for A1", skus, parseErr)
+	}
+}
@@ -0,0 +1,122 @@
+package suppliers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnknownSupplier возвращается, когда поставщик не зарегистрирован.
+	ErrUnknownSupplier = errors.New("unknown supplier")
+	// ErrUnsupportedFormat возвращается, когда у поставщика нет разметки для формата фида.
+	ErrUnsupportedFormat = errors.New("unsupported feed format")
+)
+
+// Supplier поставщик, фид которого приводится к общему виду офферов.
+type Supplier interface {
+	// Name имя поставщика, под которым его склады лежат в supplier_stores.
+	Name() string
+
+	// Stream потоково разбирает фид. Битые офферы приходят как Item с *ParseError
+	// и не прерывают разбор; любая другая ошибка завершает поток.
+	Stream(ctx context.Context, data io.Reader, contentType string) (<-chan Item, error)
+}
+
+// Offer оффер поставщика в общем виде.
+type Offer struct {
+	SKU      string
+	Title    string
+	Brand    string
+	Barcodes []string
+	// Expiration срок годности, нулевое значение если поставщик его не прислал.
+	Expiration time.Time
+	// Prices цены по городам, под ключом 0 цена для всех городов.
+	Prices map[int]int
+	Stocks []Stock
+	Line   int
+}
+
+// Stock остаток оффера на складе поставщика.
+type Stock struct {
+	StoreId string
+	Count   int
+}
+
+// Price возвращает цену для города, а если ее нет, общую цену.
+func (o Offer) Price(cityId int) int {
+	if price, ok := o.Prices[cityId]; ok && price > 0 {
+		return price
+	}
+	return o.Prices[0]
+}
+
+// Item элемент потока офферов.
+type Item struct {
+	Offer Offer
+	Err   error
+}
+
+// ParseError описывает оффер, который не удалось разобрать.
+type ParseError struct {
+	Line   int
+	Offset int64
+	SKU    string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("malformed offer %q at line %d, offset %d: %v", e.SKU, e.Line, e.Offset, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Registry реестр поставщиков по имени.
+type Registry struct {
+	mu        sync.RWMutex
+	suppliers map[string]Supplier
+}
+
+func NewRegistry(suppliers ...Supplier) *Registry {
+	registry := &Registry{suppliers: make(map[string]Supplier)}
+	for _, supplier := range suppliers {
+		registry.Register(supplier)
+	}
+	return registry
+}
+
+// Register добавляет поставщика, заменяя зарегистрированного под тем же именем.
+func (r *Registry) Register(supplier Supplier) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.suppliers[supplier.Name()] = supplier
+}
+
+// Get возвращает поставщика по имени.
+func (r *Registry) Get(name string) (Supplier, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	supplier, ok := r.suppliers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSupplier, name)
+	}
+	return supplier, nil
+}
+
+// Names возвращает имена зарегистрированных поставщиков по алфавиту.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.suppliers))
+	for name := range r.suppliers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
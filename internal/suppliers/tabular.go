@@ -0,0 +1,149 @@
+package suppliers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// tabularFormats форматы, в которых запись фида это строка таблицы.
+var tabularFormats = map[string]bool{
+	"csv":  true,
+	"xlsx": true,
+}
+
+// headerScanRows сколько строк просматривается в поисках заголовка.
+const headerScanRows = 30
+
+// rowReader отдает строки таблицы по одной, io.EOF в конце.
+type rowReader interface {
+	Read() (cells []string, line int, offset int64, err error)
+}
+
+// columns приводит имена колонок разметки к виду заголовков таблицы.
+func (m Mapping) columns() Mapping {
+	m.SKU = normalizeHeader(m.SKU)
+	m.Title = normalizeHeader(m.Title)
+	m.Brand = normalizeHeader(m.Brand)
+	m.Barcode = normalizeHeader(m.Barcode)
+	m.Expiration = normalizeHeader(m.Expiration)
+	m.Price.Path, m.Stock.Path = "", ""
+	m.Price.City = normalizeHeader(m.Price.City)
+	m.Price.Value = normalizeHeader(m.Price.Value)
+	m.Stock.Store = normalizeHeader(m.Stock.Store)
+	m.Stock.Value = normalizeHeader(m.Stock.Value)
+	m.Stock.InStock = normalizeHeader(m.Stock.InStock)
+	return m
+}
+
+// checkColumns проверяет, что в табличной разметке есть артикул и остаток. Строка без
+// остатка не дает строк импорта, и прайс-лист только с ценами ничего бы не обновил.
+func (m Mapping) checkColumns() error {
+	if m.SKU == "" {
+		return fmt.Errorf("sku column is not mapped")
+	}
+	if m.Stock.Value == "" {
+		return fmt.Errorf("stock column is not mapped")
+	}
+	return nil
+}
+
+// normalizeHeader приводит заголовок колонки к нижнему регистру, схлопывает пробелы
+// и убирает "/", чтобы заголовок годился как имя узла.
+func normalizeHeader(header string) string {
+	header = strings.ReplaceAll(header, "/", " ")
+	return strings.ToLower(strings.Join(strings.Fields(header), " "))
+}
+
+// byIndex сообщает, что разметка ссылается на колонки только по номеру и заголовок не нужен.
+func (m Mapping) byIndex() bool {
+	for _, column := range []string{m.SKU, m.Title, m.Brand, m.Barcode, m.Expiration, m.Price.City, m.Price.Value, m.Stock.Store, m.Stock.Value, m.Stock.InStock} {
+		if column != "" && !strings.HasPrefix(column, "#") {
+			return false
+		}
+	}
+	return true
+}
+
+// isHeader сообщает, что строка содержит колонку SKU и колонку цены или остатка.
+func (m Mapping) isHeader(cells []string) bool {
+	found := make(map[string]bool, len(cells))
+	for _, cell := range cells {
+		found[normalizeHeader(cell)] = true
+	}
+	return found[m.SKU] && (found[m.Price.Value] || found[m.Stock.Value])
+}
+
+// streamRows находит заголовок и отдает строки таблицы как узлы с колонками по заголовку и номеру.
+func streamRows(ctx context.Context, rows rowReader, mapping Mapping, emit emitFunc) error {
+	if err := mapping.checkColumns(); err != nil {
+		return err
+	}
+
+	var header []string
+	headerFound := mapping.byIndex() && mapping.HeaderRow == 0
+	for row := 1; ; row++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cells, line, offset, err := rows.Read()
+		if err == io.EOF {
+			if !headerFound {
+				return fmt.Errorf("header row not found")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !headerFound {
+			switch {
+			case mapping.HeaderRow > 0 && row == mapping.HeaderRow:
+				headerFound = true
+			case mapping.HeaderRow == 0 && mapping.isHeader(cells):
+				headerFound = true
+			case mapping.HeaderRow == 0 && row >= headerScanRows:
+				return fmt.Errorf("header row not found in first %d rows", headerScanRows)
+			}
+			if headerFound && !mapping.byIndex() {
+				header = make([]string, len(cells))
+				for i, cell := range cells {
+					header[i] = normalizeHeader(cell)
+				}
+			}
+			continue
+		}
+
+		if isEmptyRow(cells) {
+			continue
+		}
+		if err := emit(rowNode(header, cells), line, offset, nil); err != nil {
+			return err
+		}
+	}
+}
+
+func isEmptyRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// rowNode собирает узел строки: каждая ячейка доступна по номеру "#N" и по заголовку.
+func rowNode(header, cells []string) *Node {
+	node := &Node{Name: "row"}
+	for i, cell := range cells {
+		cell = strings.TrimSpace(cell)
+		node.Children = append(node.Children, &Node{Name: "#" + strconv.Itoa(i+1), Text: cell})
+		if i < len(header) && header[i] != "" {
+			node.Children = append(node.Children, &Node{Name: header[i], Text: cell})
+		}
+	}
+	return node
+}
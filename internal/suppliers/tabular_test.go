@@ -0,0 +1,128 @@
+package suppliers
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sliceRows отдает заранее заданные строки таблицы.
+type sliceRows struct {
+	rows [][]string
+	next int
+}
+
+func (r *sliceRows) Read() ([]string, int, int64, error) {
+	if r.next == len(r.rows) {
+		return nil, 0, 0, io.EOF
+	}
+	r.next++
+	return r.rows[r.next-1], r.next, 0, nil
+}
+
+func TestStreamRowsHeader(t *testing.T) {
+	byName := Mapping{
+		SKU:   "Артикул",
+		Price: PriceMapping{Value: "Цена"},
+		Stock: StockMapping{Value: "Остаток"},
+	}.columns()
+	byIndex := Mapping{
+		SKU:   "#1",
+		Price: PriceMapping{Value: "#2"},
+		Stock: StockMapping{Value: "#3"},
+	}.columns()
+
+	title := []string{"Прайс-лист на 01.02.2025", "", ""}
+	header := []string{"Артикул", " Цена ", "Остаток"}
+	tests := []struct {
+		name    string
+		mapping Mapping
+		rows    [][]string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:    "header in first row",
+			mapping: byName,
+			rows:    [][]string{header, {"A1", "100", "5"}, {"A2", "200", "6"}},
+			want:    []string{"A1:100", "A2:200"},
+		},
+		{
+			name:    "header after title rows",
+			mapping: byName,
+			rows:    [][]string{title, {"", "", ""}, header, {"A1", "100", "5"}},
+			want:    []string{"A1:100"},
+		},
+		{
+			name:    "header with only sku and stock",
+			mapping: Mapping{SKU: "артикул", Price: PriceMapping{Value: "цена"}, Stock: StockMapping{Value: "остаток"}}.columns(),
+			rows:    [][]string{{"Артикул", "Остаток"}, {"A1", "5"}},
+			want:    []string{"A1:"},
+		},
+		{
+			name:    "explicit header row",
+			mapping: func() Mapping { m := byName; m.HeaderRow = 2; return m }(),
+			rows:    [][]string{header, {"Артикул", "Цена", "Остаток"}, {"A1", "100", "5"}},
+			want:    []string{"A1:100"},
+		},
+		{
+			name:    "columns by index without header",
+			mapping: byIndex,
+			rows:    [][]string{{"A1", "100", "5"}, {"A2", "200", "6"}},
+			want:    []string{"A1:100", "A2:200"},
+		},
+		{
+			name:    "columns by index with header row",
+			mapping: func() Mapping { m := byIndex; m.HeaderRow = 1; return m }(),
+			rows:    [][]string{{"sku", "price", "stock"}, {"A1", "100", "5"}},
+			want:    []string{"A1:100"},
+		},
+		{
+			name:    "empty rows skipped",
+			mapping: byName,
+			rows:    [][]string{header, {"", " ", ""}, {"A1", "100", "5"}},
+			want:    []string{"A1:100"},
+		},
+		{
+			name:    "header missing",
+			mapping: byName,
+			rows:    [][]string{title, {"A1", "100", "5"}},
+			wantErr: "header row not found",
+		},
+		{
+			name:    "header beyond scanned rows",
+			mapping: byName,
+			rows:    append(make([][]string, headerScanRows), header),
+			wantErr: "header row not found in first",
+		},
+		{
+			name:    "price only",
+			mapping: Mapping{SKU: "Артикул", Price: PriceMapping{Value: "Цена"}}.columns(),
+			rows:    [][]string{header, {"A1", "100", "5"}},
+			wantErr: "stock column is not mapped",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := streamRows(context.Background(), &sliceRows{rows: tt.rows}, tt.mapping, func(record *Node, line int, offset int64, err error) error {
+				got = append(got, record.Value(tt.mapping.SKU)+":"+record.Value(tt.mapping.Price.Value))
+				return nil
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("streamRows() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("streamRows() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("streamRows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package suppliers
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// streamXLSX читает лист книги XLSX. Книга копируется во временный файл,
+// так как zip требует произвольного доступа; строки листа читаются потоково.
+func streamXLSX(ctx context.Context, data io.Reader, mapping Mapping, emit emitFunc) error {
+	file, err := os.CreateTemp("", "supplier-*.xlsx")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	size, err := io.Copy(file, data)
+	if err != nil {
+		return fmt.Errorf("error reading XLSX: %w", err)
+	}
+	book, err := zip.NewReader(file, size)
+	if err != nil {
+		return fmt.Errorf("error opening XLSX: %w", err)
+	}
+
+	sharedStrings, err := xlsxSharedStrings(book)
+	if err != nil {
+		return err
+	}
+	sheetPath, err := xlsxSheetPath(book, mapping.Sheet)
+	if err != nil {
+		return err
+	}
+	sheet, err := book.Open(sheetPath)
+	if err != nil {
+		return fmt.Errorf("error opening XLSX sheet: %w", err)
+	}
+	defer sheet.Close()
+
+	rows := &xlsxRows{decoder: xml.NewDecoder(sheet), sharedStrings: sharedStrings}
+	return streamRows(ctx, rows, mapping, emit)
+}
+
+func xlsxDecode(book *zip.Reader, name string, v interface{}) (bool, error) {
+	file, err := book.Open(name)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error opening %s: %w", name, err)
+	}
+	defer file.Close()
+	if err := xml.NewDecoder(file).Decode(v); err != nil {
+		return false, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// xlsxSharedStrings читает общую таблицу строк книги.
+func xlsxSharedStrings(book *zip.Reader) ([]string, error) {
+	var sst struct {
+		Items []xlsxText `xml:"si"`
+	}
+	if _, err := xlsxDecode(book, "xl/sharedStrings.xml", &sst); err != nil {
+		return nil, err
+	}
+	strs := make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		strs[i] = item.String()
+	}
+	return strs, nil
+}
+
+// xlsxSheetPath находит файл листа по имени, без имени берется первый лист.
+func xlsxSheetPath(book *zip.Reader, name string) (string, error) {
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			Id   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	var rels struct {
+		Relationships []struct {
+			Id     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if ok, err := xlsxDecode(book, "xl/workbook.xml", &workbook); err != nil || !ok {
+		if err == nil {
+			err = fmt.Errorf("workbook not found")
+		}
+		return "", fmt.Errorf("error reading XLSX: %w", err)
+	}
+	if _, err := xlsxDecode(book, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+
+	for _, sheet := range workbook.Sheets {
+		if name != "" && sheet.Name != name {
+			continue
+		}
+		for _, rel := range rels.Relationships {
+			if rel.Id != sheet.Id {
+				continue
+			}
+			if strings.HasPrefix(rel.Target, "/") {
+				return strings.TrimPrefix(rel.Target, "/"), nil
+			}
+			return path.Join("xl", rel.Target), nil
+		}
+	}
+	if name != "" {
+		return "", fmt.Errorf("XLSX sheet %q not found", name)
+	}
+	return "xl/worksheets/sheet1.xml", nil
+}
+
+// xlsxText текст общей строки или ячейки со встроенной строкой, в том числе форматированный по частям.
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	var text strings.Builder
+	text.WriteString(t.T)
+	for _, run := range t.Runs {
+		text.WriteString(run.T)
+	}
+	return text.String()
+}
+
+type xlsxRow struct {
+	R     int `xml:"r,attr"`
+	Cells []struct {
+		R      string   `xml:"r,attr"`
+		T      string   `xml:"t,attr"`
+		V      string   `xml:"v"`
+		Inline xlsxText `xml:"is"`
+	} `xml:"c"`
+}
+
+type xlsxRows struct {
+	decoder       *xml.Decoder
+	sharedStrings []string
+	row           int
+}
+
+func (r *xlsxRows) Read() ([]string, int, int64, error) {
+	for {
+		token, err := r.decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, 0, 0, err
+			}
+			return nil, 0, 0, fmt.Errorf("error parsing XLSX sheet: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		var row xlsxRow
+		if err := r.decoder.DecodeElement(&row, &start); err != nil {
+			return nil, 0, 0, fmt.Errorf("error parsing XLSX sheet: %w", err)
+		}
+		r.row++
+		if row.R == 0 {
+			row.R = r.row
+		}
+
+		var cells []string
+		for i, cell := range row.Cells {
+			column := xlsxColumn(cell.R)
+			if column < 0 {
+				column = i
+			}
+			for len(cells) <= column {
+				cells = append(cells, "")
+			}
+
+			value := cell.V
+			switch cell.T {
+			case "s":
+				index, err := strconv.Atoi(cell.V)
+				if err != nil || index < 0 || index >= len(r.sharedStrings) {
+					return nil, 0, 0, fmt.Errorf("invalid shared string %q in cell %s", cell.V, cell.R)
+				}
+				value = r.sharedStrings[index]
+			case "inlineStr":
+				value = cell.Inline.String()
+			}
+			cells[column] = value
+		}
+		return cells, row.R, 0, nil
+	}
+}
+
+// xlsxColumn возвращает номер колонки (с 0) по ссылке на ячейку вида "AB12".
+func xlsxColumn(ref string) int {
+	column := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		column = column*26 + int(r-'A'+1)
+	}
+	return column - 1
+}
@@ -0,0 +1,24 @@
+package suppliers
+
+import "testing"
+
+func TestXlsxColumn(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want int
+	}{
+		{ref: "A1", want: 0},
+		{ref: "B7", want: 1},
+		{ref: "Z3", want: 25},
+		{ref: "AA10", want: 26},
+		{ref: "AB12", want: 27},
+		{ref: "AZ1", want: 51},
+		{ref: "BA1", want: 52},
+		{ref: "", want: -1},
+	}
+	for _, tt := range tests {
+		if got := xlsxColumn(tt.ref); got != tt.want {
+			t.Errorf("xlsxColumn(%q) = %d, want %d", tt.ref, got, tt.want)
+		}
+	}
+}
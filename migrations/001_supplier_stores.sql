@@ -0,0 +1,9 @@
+-- Соответствие складов поставщика (storeId в фиде) нашим аптекам.
+CREATE TABLE IF NOT EXISTS supplier_stores
+(
+    id          INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    supplier    VARCHAR(64)  NOT NULL,
+    store_id    VARCHAR(128) NOT NULL,
+    pharmacy_id INT          NOT NULL,
+    UNIQUE KEY supplier_stores_supplier_store_id (supplier, store_id)
+);
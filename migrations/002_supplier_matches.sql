@@ -0,0 +1,33 @@
+-- Очередь сопоставления неизвестных SKU поставщиков с нашими продуктами.
+CREATE TABLE IF NOT EXISTS supplier_matches
+(
+    id          INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    supplier    VARCHAR(64)  NOT NULL,
+    sku         VARCHAR(128) NOT NULL,
+    store_id    VARCHAR(128) NOT NULL DEFAULT '',
+    pharmacy_id INT          NOT NULL,
+    title       VARCHAR(512) NOT NULL DEFAULT '',
+    brand       VARCHAR(255) NOT NULL DEFAULT '',
+    barcodes    VARCHAR(512) NOT NULL DEFAULT '',
+    price       INT          NOT NULL DEFAULT 0,
+    count       INT          NOT NULL DEFAULT 0,
+    status      ENUM ('pending', 'accepted', 'rejected') NOT NULL DEFAULT 'pending',
+    product_id  INT          NULL,
+    created_at  DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY supplier_matches_supplier_sku_pharmacy (supplier, sku, pharmacy_id),
+    KEY supplier_matches_status (status, supplier)
+);
+
+-- Кандидаты в продукты для записи очереди.
+CREATE TABLE IF NOT EXISTS supplier_match_candidates
+(
+    id         INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    match_id   INT UNSIGNED  NOT NULL,
+    product_id INT           NOT NULL,
+    title      VARCHAR(512)  NOT NULL DEFAULT '',
+    source     ENUM ('barcode', 'search') NOT NULL,
+    confidence DECIMAL(5, 4) NOT NULL,
+    KEY supplier_match_candidates_match_id (match_id),
+    CONSTRAINT supplier_match_candidates_match_fk FOREIGN KEY (match_id) REFERENCES supplier_matches (id) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+-- Строки, скрытые полной сверкой фида поставщика: в выдачу и индекс не попадают.
+ALTER TABLE product_pharmacy
+    ADD COLUMN is_hidden TINYINT(1) NOT NULL DEFAULT 0;
@@ -0,0 +1,51 @@
+-- История запусков импорта фидов поставщиков.
+CREATE TABLE IF NOT EXISTS supplier_import_runs
+(
+    id          INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    supplier    VARCHAR(64)  NOT NULL,
+    source      VARCHAR(512) NOT NULL DEFAULT '',
+    feed_hash   CHAR(64)     NOT NULL DEFAULT '',
+    mode        ENUM ('import', 'sync') NOT NULL,
+    status      ENUM ('running', 'success', 'failed') NOT NULL DEFAULT 'running',
+    error       TEXT         NULL,
+    updated     INT          NOT NULL DEFAULT 0,
+    unchanged   INT          NOT NULL DEFAULT 0,
+    unknown     INT          NOT NULL DEFAULT 0,
+    failed      INT          NOT NULL DEFAULT 0,
+    withdrawn   INT          NOT NULL DEFAULT 0,
+    started_at  DATETIME     NOT NULL,
+    finished_at DATETIME     NULL,
+    KEY supplier_import_runs_supplier (supplier, started_at)
+);
+
+-- Изменения цены и остатка строк product_pharmacy, сделанные запуском импорта.
+CREATE TABLE IF NOT EXISTS supplier_import_changes
+(
+    id          INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    run_id      INT UNSIGNED NOT NULL,
+    product_id  INT          NOT NULL,
+    sku         VARCHAR(128) NOT NULL,
+    store_id    VARCHAR(128) NOT NULL DEFAULT '',
+    pharmacy_id INT          NOT NULL,
+    old_price   INT          NOT NULL,
+    new_price   INT          NOT NULL,
+    old_count   INT          NOT NULL,
+    new_count   INT          NOT NULL,
+    KEY supplier_import_changes_run_id (run_id),
+    KEY supplier_import_changes_sku (sku, pharmacy_id),
+    CONSTRAINT supplier_import_changes_run_fk FOREIGN KEY (run_id) REFERENCES supplier_import_runs (id) ON DELETE CASCADE
+);
+
+-- Строки фида, которые запуск импорта не смог применить.
+CREATE TABLE IF NOT EXISTS supplier_import_failures
+(
+    id          INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    run_id      INT UNSIGNED NOT NULL,
+    sku         VARCHAR(128) NOT NULL DEFAULT '',
+    store_id    VARCHAR(128) NOT NULL DEFAULT '',
+    pharmacy_id INT          NOT NULL DEFAULT 0,
+    line        INT          NOT NULL DEFAULT 0,
+    error       TEXT         NOT NULL,
+    KEY supplier_import_failures_run_id (run_id),
+    CONSTRAINT supplier_import_failures_run_fk FOREIGN KEY (run_id) REFERENCES supplier_import_runs (id) ON DELETE CASCADE
+);
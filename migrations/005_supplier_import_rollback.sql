@@ -0,0 +1,10 @@
+-- Откат запусков импорта: сам откат пишется запуском с режимом rollback,
+-- откаченный запуск ссылается на него.
+ALTER TABLE supplier_import_runs
+    MODIFY mode ENUM ('import', 'sync', 'rollback') NOT NULL,
+    ADD COLUMN rolled_back_by INT UNSIGNED NULL;
+
+-- Скрытие строк полной сверкой тоже откатывается.
+ALTER TABLE supplier_import_changes
+    ADD COLUMN old_hidden TINYINT(1) NOT NULL DEFAULT 0,
+    ADD COLUMN new_hidden TINYINT(1) NOT NULL DEFAULT 0;
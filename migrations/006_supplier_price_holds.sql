@@ -0,0 +1,24 @@
+-- Изменения цены и остатка из фидов, задержанные правилами проверки до решения администратора.
+CREATE TABLE IF NOT EXISTS supplier_price_holds
+(
+    id          INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    run_id      INT UNSIGNED NULL,
+    supplier    VARCHAR(64)  NOT NULL,
+    product_id  INT          NOT NULL,
+    sku         VARCHAR(128) NOT NULL,
+    store_id    VARCHAR(128) NOT NULL DEFAULT '',
+    pharmacy_id INT          NOT NULL,
+    old_price   INT          NOT NULL,
+    new_price   INT          NOT NULL,
+    old_count   INT          NOT NULL,
+    new_count   INT          NOT NULL,
+    reason      VARCHAR(255) NOT NULL,
+    status      ENUM ('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending',
+    created_at  DATETIME     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at DATETIME     NULL,
+    KEY supplier_price_holds_row (supplier, sku, pharmacy_id, status),
+    KEY supplier_price_holds_status (status, supplier)
+);
+
+ALTER TABLE supplier_import_runs
+    ADD COLUMN held INT NOT NULL DEFAULT 0 AFTER withdrawn;
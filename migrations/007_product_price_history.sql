@@ -0,0 +1,12 @@
+-- История цены и остатка продукта в аптеке, пишется только при изменении.
+CREATE TABLE IF NOT EXISTS product_price_history
+(
+    id          BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    product_id  INT      NOT NULL,
+    pharmacy_id INT      NOT NULL,
+    price       INT      NOT NULL,
+    count       INT      NOT NULL,
+    recorded_at DATETIME NOT NULL,
+    KEY product_price_history_product (product_id, recorded_at),
+    KEY product_price_history_pharmacy (product_id, pharmacy_id, recorded_at)
+);
@@ -0,0 +1,3 @@
+-- Срок годности партии, которую поставщик отдает по строке. NULL, если поставщик его не присылает.
+ALTER TABLE product_pharmacy
+    ADD COLUMN expiration_date DATE NULL;
@@ -0,0 +1,3 @@
+-- Импорт фидов ищет и обновляет строки по (sku, pharmacy_id): без индекса каждая
+-- пачка сканирует таблицу и блокирует лишние строки.
+CREATE INDEX product_pharmacy_sku_pharmacy_id ON product_pharmacy (sku, pharmacy_id);
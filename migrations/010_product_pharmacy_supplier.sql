@@ -0,0 +1,24 @@
+-- Поставщик, чей фид ведет строку. Полная сверка снимает только строки своего поставщика,
+-- а не все строки аптек его складов. NULL, если строку не вел ни один фид.
+ALTER TABLE product_pharmacy
+    ADD COLUMN supplier VARCHAR(64) NULL,
+    ADD INDEX product_pharmacy_supplier (supplier, pharmacy_id);
+
+-- Строки, которые уже меняли запуски импорта, отдаются поставщику последнего из них.
+UPDATE product_pharmacy
+    JOIN (
+        SELECT changes.sku, changes.pharmacy_id, MAX(changes.run_id) AS run_id
+        FROM supplier_import_changes changes
+            JOIN supplier_import_runs runs ON runs.id = changes.run_id AND runs.mode IN ('import', 'sync')
+        GROUP BY changes.sku, changes.pharmacy_id
+    ) latest ON latest.sku = product_pharmacy.sku AND latest.pharmacy_id = product_pharmacy.pharmacy_id
+    JOIN supplier_import_runs runs ON runs.id = latest.run_id
+SET product_pharmacy.supplier = runs.supplier;
+
+-- Строки, созданные из очереди сопоставления, принадлежат ее поставщику.
+UPDATE product_pharmacy
+    JOIN supplier_matches matches ON matches.sku = product_pharmacy.sku
+        AND matches.pharmacy_id = product_pharmacy.pharmacy_id
+        AND matches.status = 'accepted'
+SET product_pharmacy.supplier = matches.supplier
+WHERE product_pharmacy.supplier IS NULL;
@@ -0,0 +1,7 @@
+-- Одобрение задержанного изменения пишется запуском approve, чтобы его можно было откатить.
+ALTER TABLE supplier_import_runs
+    MODIFY mode ENUM ('import', 'sync', 'rollback', 'approve') NOT NULL;
+
+-- Видимость строки на момент задержки: одобрение сверяет ее вместе с ценой и остатком.
+ALTER TABLE supplier_price_holds
+    ADD COLUMN old_hidden TINYINT(1) NOT NULL DEFAULT 0 AFTER new_count;
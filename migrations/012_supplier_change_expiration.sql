@@ -0,0 +1,9 @@
+-- Срок годности строки до и после изменения: откат и одобрение задержки возвращают его
+-- вместе с ценой и остатком. NULL, если срока не было.
+ALTER TABLE supplier_import_changes
+    ADD COLUMN old_expiration DATE NULL,
+    ADD COLUMN new_expiration DATE NULL;
+
+ALTER TABLE supplier_price_holds
+    ADD COLUMN old_expiration DATE NULL AFTER old_hidden,
+    ADD COLUMN new_expiration DATE NULL AFTER old_expiration;
@@ -0,0 +1,239 @@
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// GroupSeparator символ FNC1 (ASCII 29), которым сканер разделяет
+// элементы переменной длины в строке DataMatrix.
+const GroupSeparator = '\x1d'
+
+var (
+	ErrEmpty           = errors.New("gs1: empty code")
+	ErrInvalidGTIN     = errors.New("gs1: invalid GTIN")
+	ErrInvalidCheckSum = errors.New("gs1: invalid GTIN check digit")
+	ErrInvalidDate     = errors.New("gs1: invalid date")
+	ErrMalformed       = errors.New("gs1: malformed element string")
+)
+
+// Code результат разбора отсканированного штрихкода.
+type Code struct {
+	// GTIN нормализованный GTIN-14.
+	GTIN string
+	// Expiry срок годности из AI (17), нулевое значение если его нет.
+	Expiry time.Time
+	// Serial серийный номер из AI (21).
+	Serial string
+	// Batch номер партии из AI (10).
+	Batch string
+}
+
+// fixedLength длины элементов с фиксированной длиной данных.
+var fixedLength = map[string]int{
+	"00": 18, "01": 14, "02": 14,
+	"11": 6, "12": 6, "13": 6, "15": 6, "16": 6, "17": 6,
+}
+
+// variableLength максимальные длины элементов переменной длины.
+var variableLength = map[string]int{
+	"10": 20, "21": 20, "22": 20, "240": 30, "241": 30,
+	"91": 90, "92": 90, "93": 90,
+}
+
+// symbologyPrefixes идентификаторы символики, которые добавляют некоторые сканеры.
+var symbologyPrefixes = []string{"]d2", "]C1", "]Q3", "]e0"}
+
+// Parse разбирает EAN-8/UPC-A/EAN-13, GTIN-14 или строку элементов GS1
+// в скобочном виде "(01)...(17)...(21)..." либо в сыром виде с FNC1.
+func Parse(raw string) (Code, error) {
+	s := strings.TrimSpace(raw)
+	for _, prefix := range symbologyPrefixes {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	s = strings.TrimLeft(s, string(GroupSeparator))
+	if s == "" {
+		return Code{}, ErrEmpty
+	}
+
+	if isDigits(s) && len(s) <= 14 {
+		gtin, err := NormalizeGTIN(s)
+		if err != nil {
+			return Code{}, err
+		}
+		return Code{GTIN: gtin}, nil
+	}
+
+	var elements map[string]string
+	var err error
+	if strings.HasPrefix(s, "(") {
+		elements, err = parseBracketed(s)
+	} else {
+		elements, err = parseRaw(s)
+	}
+	if err != nil {
+		return Code{}, err
+	}
+
+	gtin, ok := elements["01"]
+	if !ok {
+		gtin, ok = elements["02"]
+	}
+	if !ok {
+		return Code{}, fmt.Errorf("%w: no GTIN element", ErrMalformed)
+	}
+
+	code := Code{Serial: elements["21"], Batch: elements["10"]}
+	if code.GTIN, err = NormalizeGTIN(gtin); err != nil {
+		return Code{}, err
+	}
+	if expiry, ok := elements["17"]; ok {
+		if code.Expiry, err = parseDate(expiry); err != nil {
+			return Code{}, err
+		}
+	}
+	return code, nil
+}
+
+// NormalizeGTIN проверяет контрольную цифру и дополняет код нулями до GTIN-14.
+func NormalizeGTIN(code string) (string, error) {
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidGTIN, code)
+	}
+	if !isDigits(code) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidGTIN, code)
+	}
+	gtin := strings.Repeat("0", 14-len(code)) + code
+	if checkDigit(gtin[:13]) != gtin[13] {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCheckSum, code)
+	}
+	return gtin, nil
+}
+
+// Forms возвращает GTIN-14 вместе с его короткими формами GTIN-13, GTIN-12 и GTIN-8,
+// в которых код может храниться в справочнике.
+func Forms(gtin string) []string {
+	forms := []string{gtin}
+	for _, length := range []int{13, 12, 8} {
+		cut := len(gtin) - length
+		if cut <= 0 || strings.Trim(gtin[:cut], "0") != "" {
+			continue
+		}
+		forms = append(forms, gtin[cut:])
+	}
+	return forms
+}
+
+func parseBracketed(s string) (map[string]string, error) {
+	elements := map[string]string{}
+	for s != "" {
+		if s[0] != '(' {
+			return nil, fmt.Errorf("%w: expected '(' in %q", ErrMalformed, s)
+		}
+		end := strings.IndexByte(s, ')')
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unclosed AI in %q", ErrMalformed, s)
+		}
+		ai := s[1:end]
+		s = s[end+1:]
+		next := strings.IndexByte(s, '(')
+		if next < 0 {
+			next = len(s)
+		}
+		elements[ai] = strings.TrimRight(s[:next], string(GroupSeparator))
+		s = s[next:]
+	}
+	return elements, nil
+}
+
+func parseRaw(s string) (map[string]string, error) {
+	elements := map[string]string{}
+	for s != "" {
+		ai, length, fixed := lookupAI(s)
+		if ai == "" {
+			return nil, fmt.Errorf("%w: unknown AI at %q", ErrMalformed, s)
+		}
+		s = s[len(ai):]
+		if fixed {
+			if len(s) < length {
+				return nil, fmt.Errorf("%w: AI (%s) is truncated", ErrMalformed, ai)
+			}
+			elements[ai] = s[:length]
+			s = s[length:]
+		} else {
+			end := strings.IndexRune(s, GroupSeparator)
+			if end < 0 {
+				end = len(s)
+			}
+			if end > length {
+				end = length
+			}
+			elements[ai] = s[:end]
+			s = s[end:]
+		}
+		s = strings.TrimLeft(s, string(GroupSeparator))
+	}
+	return elements, nil
+}
+
+func lookupAI(s string) (ai string, length int, fixed bool) {
+	for _, n := range []int{2, 3} {
+		if len(s) < n {
+			break
+		}
+		if l, ok := fixedLength[s[:n]]; ok {
+			return s[:n], l, true
+		}
+		if l, ok := variableLength[s[:n]]; ok {
+			return s[:n], l, false
+		}
+	}
+	return "", 0, false
+}
+
+// parseDate разбирает дату YYMMDD. День 00 означает последний день месяца.
+func parseDate(s string) (time.Time, error) {
+	if len(s) != 6 || !isDigits(s) {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+	year := 2000 + int(s[0]-'0')*10 + int(s[1]-'0')
+	month := time.Month(int(s[2]-'0')*10 + int(s[3]-'0'))
+	day := int(s[4]-'0')*10 + int(s[5]-'0')
+	if month < 1 || month > 12 || day > 31 {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+	if day == 0 {
+		return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC), nil
+	}
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if date.Month() != month {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, s)
+	}
+	return date, nil
+}
+
+func checkDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) || r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return s != ""
+}
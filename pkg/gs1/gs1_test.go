@@ -0,0 +1,89 @@
+package gs1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    Code
+		wantErr error
+	}{
+		{name: "EAN-13", raw: "4006381333931", want: Code{GTIN: "04006381333931"}},
+		{name: "EAN-8", raw: "96385074", want: Code{GTIN: "00000096385074"}},
+		{name: "UPC-A", raw: "036000291452", want: Code{GTIN: "00036000291452"}},
+		{name: "GTIN-14 with spaces", raw: " 04006381333931\n", want: Code{GTIN: "04006381333931"}},
+		{
+			name: "raw DataMatrix",
+			raw:  "0104006381333931172512312112345ABC\x1d10LOT7",
+			want: Code{GTIN: "04006381333931", Expiry: date(2025, 12, 31), Serial: "12345ABC", Batch: "LOT7"},
+		},
+		{
+			name: "raw DataMatrix with symbology prefix and leading FNC1",
+			raw:  "]d2\x1d01040063813339312112345ABC\x1d17260315",
+			want: Code{GTIN: "04006381333931", Expiry: date(2026, 3, 15), Serial: "12345ABC"},
+		},
+		{
+			name: "bracketed",
+			raw:  "(01)04006381333931(17)250200(10)A1",
+			want: Code{GTIN: "04006381333931", Expiry: date(2025, 2, 28), Batch: "A1"},
+		},
+		{
+			name: "crypto tail after serial",
+			raw:  "010400638133393121SN1\x1d91EE06\x1d92abcdef",
+			want: Code{GTIN: "04006381333931", Serial: "SN1"},
+		},
+		{name: "empty", raw: " \x1d", wantErr: ErrEmpty},
+		{name: "wrong check digit", raw: "4006381333932", wantErr: ErrInvalidCheckSum},
+		{name: "wrong length", raw: "12345", wantErr: ErrInvalidGTIN},
+		{name: "invalid month", raw: "(01)04006381333931(17)251301", wantErr: ErrInvalidDate},
+		{name: "invalid day", raw: "(01)04006381333931(17)250230", wantErr: ErrInvalidDate},
+		{name: "no GTIN", raw: "(21)SN1(10)A1", wantErr: ErrMalformed},
+		{name: "unknown AI", raw: "0104006381333931995", wantErr: ErrMalformed},
+		{name: "truncated fixed element", raw: "01040063813339311725", wantErr: ErrMalformed},
+		{name: "unclosed AI", raw: "(01)04006381333931(17", wantErr: ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.raw)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Parse(%q) error = %v, want %v", tt.raw, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.raw, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForms(t *testing.T) {
+	tests := []struct {
+		gtin string
+		want []string
+	}{
+		{gtin: "04006381333931", want: []string{"04006381333931", "4006381333931"}},
+		{gtin: "00036000291452", want: []string{"00036000291452", "0036000291452", "036000291452"}},
+		{gtin: "00000096385074", want: []string{"00000096385074", "0000096385074", "000096385074", "96385074"}},
+		{gtin: "14006381333938", want: []string{"14006381333938"}},
+	}
+	for _, tt := range tests {
+		if got := Forms(tt.gtin); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Forms(%q) = %v, want %v", tt.gtin, got, tt.want)
+		}
+	}
+}
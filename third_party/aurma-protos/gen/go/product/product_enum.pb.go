@@ -0,0 +1,194 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.2
+// source: product_enum.proto
+
+package product
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProductSearchSort int32
+
+const (
+	ProductSearchSort_DEFAULT    ProductSearchSort = 0
+	ProductSearchSort_PRICE_DESC ProductSearchSort = 1 //сначала дорогие
+	ProductSearchSort_PRICE_ASC  ProductSearchSort = 2 //сначала дешевые
+	ProductSearchSort_COUNT_DESC ProductSearchSort = 3
+	ProductSearchSort_COUNT_ASC  ProductSearchSort = 4
+)
+
+// Enum value maps for ProductSearchSort.
+var (
+	ProductSearchSort_name = map[int32]string{
+		0: "DEFAULT",
+		1: "PRICE_DESC",
+		2: "PRICE_ASC",
+		3: "COUNT_DESC",
+		4: "COUNT_ASC",
+	}
+	ProductSearchSort_value = map[string]int32{
+		"DEFAULT":    0,
+		"PRICE_DESC": 1,
+		"PRICE_ASC":  2,
+		"COUNT_DESC": 3,
+		"COUNT_ASC":  4,
+	}
+)
+
+func (x ProductSearchSort) Enum() *ProductSearchSort {
+	p := new(ProductSearchSort)
+	*p = x
+	return p
+}
+
+func (x ProductSearchSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductSearchSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_enum_proto_enumTypes[0].Descriptor()
+}
+
+func (ProductSearchSort) Type() protoreflect.EnumType {
+	return &file_product_enum_proto_enumTypes[0]
+}
+
+func (x ProductSearchSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductSearchSort.Descriptor instead.
+func (ProductSearchSort) EnumDescriptor() ([]byte, []int) {
+	return file_product_enum_proto_rawDescGZIP(), []int{0}
+}
+
+type ProductAvailability int32
+
+const (
+	ProductAvailability_ANY          ProductAvailability = 0
+	ProductAvailability_IN_STOCK     ProductAvailability = 1
+	ProductAvailability_OUT_OF_STOCK ProductAvailability = 2
+)
+
+// Enum value maps for ProductAvailability.
+var (
+	ProductAvailability_name = map[int32]string{
+		0: "ANY",
+		1: "IN_STOCK",
+		2: "OUT_OF_STOCK",
+	}
+	ProductAvailability_value = map[string]int32{
+		"ANY":          0,
+		"IN_STOCK":     1,
+		"OUT_OF_STOCK": 2,
+	}
+)
+
+func (x ProductAvailability) Enum() *ProductAvailability {
+	p := new(ProductAvailability)
+	*p = x
+	return p
+}
+
+func (x ProductAvailability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductAvailability) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_enum_proto_enumTypes[1].Descriptor()
+}
+
+func (ProductAvailability) Type() protoreflect.EnumType {
+	return &file_product_enum_proto_enumTypes[1]
+}
+
+func (x ProductAvailability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductAvailability.Descriptor instead.
+func (ProductAvailability) EnumDescriptor() ([]byte, []int) {
+	return file_product_enum_proto_rawDescGZIP(), []int{1}
+}
+
+var File_product_enum_proto protoreflect.FileDescriptor
+
+var file_product_enum_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2a, 0x5e, 0x0a,
+	0x11, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f,
+	0x72, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x02, 0x12, 0x0e,
+	0x0a, 0x0a, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x03, 0x12, 0x0d,
+	0x0a, 0x09, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x04, 0x2a, 0x3e, 0x0a,
+	0x13, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f,
+	0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x42, 0x0f, 0x5a,
+	0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_product_enum_proto_rawDescOnce sync.Once
+	file_product_enum_proto_rawDescData = file_product_enum_proto_rawDesc
+)
+
+func file_product_enum_proto_rawDescGZIP() []byte {
+	file_product_enum_proto_rawDescOnce.Do(func() {
+		file_product_enum_proto_rawDescData = protoimpl.X.CompressGZIP(file_product_enum_proto_rawDescData)
+	})
+	return file_product_enum_proto_rawDescData
+}
+
+var file_product_enum_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_product_enum_proto_goTypes = []any{
+	(ProductSearchSort)(0),   // 0: product.ProductSearchSort
+	(ProductAvailability)(0), // 1: product.ProductAvailability
+}
+var file_product_enum_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_product_enum_proto_init() }
+func file_product_enum_proto_init() {
+	if File_product_enum_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_product_enum_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_product_enum_proto_goTypes,
+		DependencyIndexes: file_product_enum_proto_depIdxs,
+		EnumInfos:         file_product_enum_proto_enumTypes,
+	}.Build()
+	File_product_enum_proto = out.File
+	file_product_enum_proto_rawDesc = nil
+	file_product_enum_proto_goTypes = nil
+	file_product_enum_proto_depIdxs = nil
+}
@@ -0,0 +1,729 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.2
+// source: product_service.proto
+
+package product
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProductService_Search_FullMethodName                 = "/product.ProductService/Search"
+	ProductService_Show_FullMethodName                   = "/product.ProductService/Show"
+	ProductService_Offers_FullMethodName                 = "/product.ProductService/Offers"
+	ProductService_Suggest_FullMethodName                = "/product.ProductService/Suggest"
+	ProductService_ScanBarcode_FullMethodName            = "/product.ProductService/ScanBarcode"
+	ProductService_Analogs_FullMethodName                = "/product.ProductService/Analogs"
+	ProductService_SupplierMatches_FullMethodName        = "/product.ProductService/SupplierMatches"
+	ProductService_AcceptSupplierMatch_FullMethodName    = "/product.ProductService/AcceptSupplierMatch"
+	ProductService_RejectSupplierMatch_FullMethodName    = "/product.ProductService/RejectSupplierMatch"
+	ProductService_SupplierImportRuns_FullMethodName     = "/product.ProductService/SupplierImportRuns"
+	ProductService_SupplierImportRun_FullMethodName      = "/product.ProductService/SupplierImportRun"
+	ProductService_RollbackSupplierImport_FullMethodName = "/product.ProductService/RollbackSupplierImport"
+	ProductService_SupplierDryRun_FullMethodName         = "/product.ProductService/SupplierDryRun"
+	ProductService_PriceHolds_FullMethodName             = "/product.ProductService/PriceHolds"
+	ProductService_ApprovePriceHold_FullMethodName       = "/product.ProductService/ApprovePriceHold"
+	ProductService_RejectPriceHold_FullMethodName        = "/product.ProductService/RejectPriceHold"
+	ProductService_ProductPriceHistory_FullMethodName    = "/product.ProductService/ProductPriceHistory"
+)
+
+// ProductServiceClient is the client API for ProductService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProductServiceClient interface {
+	Search(ctx context.Context, in *ProductSearchRequest, opts ...grpc.CallOption) (*ProductSearchResponse, error)
+	Show(ctx context.Context, in *ProductShowRequest, opts ...grpc.CallOption) (*ProductShowResponse, error)
+	Offers(ctx context.Context, in *ProductOffersRequest, opts ...grpc.CallOption) (*ProductOffersResponse, error)
+	Suggest(ctx context.Context, in *ProductSuggestRequest, opts ...grpc.CallOption) (*ProductSuggestResponse, error)
+	ScanBarcode(ctx context.Context, in *ProductBarcodeRequest, opts ...grpc.CallOption) (*ProductBarcodeResponse, error)
+	Analogs(ctx context.Context, in *ProductAnalogsRequest, opts ...grpc.CallOption) (*ProductAnalogsResponse, error)
+	SupplierMatches(ctx context.Context, in *SupplierMatchesRequest, opts ...grpc.CallOption) (*SupplierMatchesResponse, error)
+	AcceptSupplierMatch(ctx context.Context, in *SupplierMatchAcceptRequest, opts ...grpc.CallOption) (*SupplierMatchResponse, error)
+	RejectSupplierMatch(ctx context.Context, in *SupplierMatchRejectRequest, opts ...grpc.CallOption) (*SupplierMatchResponse, error)
+	SupplierImportRuns(ctx context.Context, in *SupplierImportRunsRequest, opts ...grpc.CallOption) (*SupplierImportRunsResponse, error)
+	SupplierImportRun(ctx context.Context, in *SupplierImportRunRequest, opts ...grpc.CallOption) (*SupplierImportRunResponse, error)
+	RollbackSupplierImport(ctx context.Context, in *SupplierImportRollbackRequest, opts ...grpc.CallOption) (*SupplierImportRollbackResponse, error)
+	SupplierDryRun(ctx context.Context, in *SupplierDryRunRequest, opts ...grpc.CallOption) (*SupplierDryRunResponse, error)
+	PriceHolds(ctx context.Context, in *PriceHoldsRequest, opts ...grpc.CallOption) (*PriceHoldsResponse, error)
+	ApprovePriceHold(ctx context.Context, in *PriceHoldRequest, opts ...grpc.CallOption) (*PriceHoldResponse, error)
+	RejectPriceHold(ctx context.Context, in *PriceHoldRequest, opts ...grpc.CallOption) (*PriceHoldResponse, error)
+	ProductPriceHistory(ctx context.Context, in *ProductPriceHistoryRequest, opts ...grpc.CallOption) (*ProductPriceHistoryResponse, error)
+}
+
+type productServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductServiceClient(cc grpc.ClientConnInterface) ProductServiceClient {
+	return &productServiceClient{cc}
+}
+
+func (c *productServiceClient) Search(ctx context.Context, in *ProductSearchRequest, opts ...grpc.CallOption) (*ProductSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductSearchResponse)
+	err := c.cc.Invoke(ctx, ProductService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) Show(ctx context.Context, in *ProductShowRequest, opts ...grpc.CallOption) (*ProductShowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductShowResponse)
+	err := c.cc.Invoke(ctx, ProductService_Show_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) Offers(ctx context.Context, in *ProductOffersRequest, opts ...grpc.CallOption) (*ProductOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductOffersResponse)
+	err := c.cc.Invoke(ctx, ProductService_Offers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) Suggest(ctx context.Context, in *ProductSuggestRequest, opts ...grpc.CallOption) (*ProductSuggestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductSuggestResponse)
+	err := c.cc.Invoke(ctx, ProductService_Suggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ScanBarcode(ctx context.Context, in *ProductBarcodeRequest, opts ...grpc.CallOption) (*ProductBarcodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductBarcodeResponse)
+	err := c.cc.Invoke(ctx, ProductService_ScanBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) Analogs(ctx context.Context, in *ProductAnalogsRequest, opts ...grpc.CallOption) (*ProductAnalogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductAnalogsResponse)
+	err := c.cc.Invoke(ctx, ProductService_Analogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SupplierMatches(ctx context.Context, in *SupplierMatchesRequest, opts ...grpc.CallOption) (*SupplierMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierMatchesResponse)
+	err := c.cc.Invoke(ctx, ProductService_SupplierMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AcceptSupplierMatch(ctx context.Context, in *SupplierMatchAcceptRequest, opts ...grpc.CallOption) (*SupplierMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierMatchResponse)
+	err := c.cc.Invoke(ctx, ProductService_AcceptSupplierMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RejectSupplierMatch(ctx context.Context, in *SupplierMatchRejectRequest, opts ...grpc.CallOption) (*SupplierMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierMatchResponse)
+	err := c.cc.Invoke(ctx, ProductService_RejectSupplierMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SupplierImportRuns(ctx context.Context, in *SupplierImportRunsRequest, opts ...grpc.CallOption) (*SupplierImportRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierImportRunsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SupplierImportRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SupplierImportRun(ctx context.Context, in *SupplierImportRunRequest, opts ...grpc.CallOption) (*SupplierImportRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierImportRunResponse)
+	err := c.cc.Invoke(ctx, ProductService_SupplierImportRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RollbackSupplierImport(ctx context.Context, in *SupplierImportRollbackRequest, opts ...grpc.CallOption) (*SupplierImportRollbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierImportRollbackResponse)
+	err := c.cc.Invoke(ctx, ProductService_RollbackSupplierImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SupplierDryRun(ctx context.Context, in *SupplierDryRunRequest, opts ...grpc.CallOption) (*SupplierDryRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierDryRunResponse)
+	err := c.cc.Invoke(ctx, ProductService_SupplierDryRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PriceHolds(ctx context.Context, in *PriceHoldsRequest, opts ...grpc.CallOption) (*PriceHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceHoldsResponse)
+	err := c.cc.Invoke(ctx, ProductService_PriceHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ApprovePriceHold(ctx context.Context, in *PriceHoldRequest, opts ...grpc.CallOption) (*PriceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceHoldResponse)
+	err := c.cc.Invoke(ctx, ProductService_ApprovePriceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RejectPriceHold(ctx context.Context, in *PriceHoldRequest, opts ...grpc.CallOption) (*PriceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceHoldResponse)
+	err := c.cc.Invoke(ctx, ProductService_RejectPriceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ProductPriceHistory(ctx context.Context, in *ProductPriceHistoryRequest, opts ...grpc.CallOption) (*ProductPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_ProductPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServiceServer is the server API for ProductService service.
+// All implementations must embed UnimplementedProductServiceServer
+// for forward compatibility.
+type ProductServiceServer interface {
+	Search(context.Context, *ProductSearchRequest) (*ProductSearchResponse, error)
+	Show(context.Context, *ProductShowRequest) (*ProductShowResponse, error)
+	Offers(context.Context, *ProductOffersRequest) (*ProductOffersResponse, error)
+	Suggest(context.Context, *ProductSuggestRequest) (*ProductSuggestResponse, error)
+	ScanBarcode(context.Context, *ProductBarcodeRequest) (*ProductBarcodeResponse, error)
+	Analogs(context.Context, *ProductAnalogsRequest) (*ProductAnalogsResponse, error)
+	SupplierMatches(context.Context, *SupplierMatchesRequest) (*SupplierMatchesResponse, error)
+	AcceptSupplierMatch(context.Context, *SupplierMatchAcceptRequest) (*SupplierMatchResponse, error)
+	RejectSupplierMatch(context.Context, *SupplierMatchRejectRequest) (*SupplierMatchResponse, error)
+	SupplierImportRuns(context.Context, *SupplierImportRunsRequest) (*SupplierImportRunsResponse, error)
+	SupplierImportRun(context.Context, *SupplierImportRunRequest) (*SupplierImportRunResponse, error)
+	RollbackSupplierImport(context.Context, *SupplierImportRollbackRequest) (*SupplierImportRollbackResponse, error)
+	SupplierDryRun(context.Context, *SupplierDryRunRequest) (*SupplierDryRunResponse, error)
+	PriceHolds(context.Context, *PriceHoldsRequest) (*PriceHoldsResponse, error)
+	ApprovePriceHold(context.Context, *PriceHoldRequest) (*PriceHoldResponse, error)
+	RejectPriceHold(context.Context, *PriceHoldRequest) (*PriceHoldResponse, error)
+	ProductPriceHistory(context.Context, *ProductPriceHistoryRequest) (*ProductPriceHistoryResponse, error)
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) Search(context.Context, *ProductSearchRequest) (*ProductSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedProductServiceServer) Show(context.Context, *ProductShowRequest) (*ProductShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Show not implemented")
+}
+func (UnimplementedProductServiceServer) Offers(context.Context, *ProductOffersRequest) (*ProductOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Offers not implemented")
+}
+func (UnimplementedProductServiceServer) Suggest(context.Context, *ProductSuggestRequest) (*ProductSuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedProductServiceServer) ScanBarcode(context.Context, *ProductBarcodeRequest) (*ProductBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanBarcode not implemented")
+}
+func (UnimplementedProductServiceServer) Analogs(context.Context, *ProductAnalogsRequest) (*ProductAnalogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analogs not implemented")
+}
+func (UnimplementedProductServiceServer) SupplierMatches(context.Context, *SupplierMatchesRequest) (*SupplierMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierMatches not implemented")
+}
+func (UnimplementedProductServiceServer) AcceptSupplierMatch(context.Context, *SupplierMatchAcceptRequest) (*SupplierMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSupplierMatch not implemented")
+}
+func (UnimplementedProductServiceServer) RejectSupplierMatch(context.Context, *SupplierMatchRejectRequest) (*SupplierMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSupplierMatch not implemented")
+}
+func (UnimplementedProductServiceServer) SupplierImportRuns(context.Context, *SupplierImportRunsRequest) (*SupplierImportRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierImportRuns not implemented")
+}
+func (UnimplementedProductServiceServer) SupplierImportRun(context.Context, *SupplierImportRunRequest) (*SupplierImportRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierImportRun not implemented")
+}
+func (UnimplementedProductServiceServer) RollbackSupplierImport(context.Context, *SupplierImportRollbackRequest) (*SupplierImportRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackSupplierImport not implemented")
+}
+func (UnimplementedProductServiceServer) SupplierDryRun(context.Context, *SupplierDryRunRequest) (*SupplierDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierDryRun not implemented")
+}
+func (UnimplementedProductServiceServer) PriceHolds(context.Context, *PriceHoldsRequest) (*PriceHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceHolds not implemented")
+}
+func (UnimplementedProductServiceServer) ApprovePriceHold(context.Context, *PriceHoldRequest) (*PriceHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePriceHold not implemented")
+}
+func (UnimplementedProductServiceServer) RejectPriceHold(context.Context, *PriceHoldRequest) (*PriceHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPriceHold not implemented")
+}
+func (UnimplementedProductServiceServer) ProductPriceHistory(context.Context, *ProductPriceHistoryRequest) (*ProductPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProductPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
+func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServiceServer will
+// result in compilation errors.
+type UnsafeProductServiceServer interface {
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProductServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Search(ctx, req.(*ProductSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_Show_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Show(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Show_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Show(ctx, req.(*ProductShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_Offers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Offers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Offers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Offers(ctx, req.(*ProductOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductSuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Suggest(ctx, req.(*ProductSuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ScanBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ScanBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ScanBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ScanBarcode(ctx, req.(*ProductBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_Analogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductAnalogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Analogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Analogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Analogs(ctx, req.(*ProductAnalogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SupplierMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SupplierMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SupplierMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SupplierMatches(ctx, req.(*SupplierMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AcceptSupplierMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierMatchAcceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AcceptSupplierMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AcceptSupplierMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AcceptSupplierMatch(ctx, req.(*SupplierMatchAcceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RejectSupplierMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierMatchRejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RejectSupplierMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RejectSupplierMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RejectSupplierMatch(ctx, req.(*SupplierMatchRejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SupplierImportRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierImportRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SupplierImportRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SupplierImportRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SupplierImportRuns(ctx, req.(*SupplierImportRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SupplierImportRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierImportRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SupplierImportRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SupplierImportRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SupplierImportRun(ctx, req.(*SupplierImportRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RollbackSupplierImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierImportRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RollbackSupplierImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RollbackSupplierImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RollbackSupplierImport(ctx, req.(*SupplierImportRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SupplierDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SupplierDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SupplierDryRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SupplierDryRun(ctx, req.(*SupplierDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PriceHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PriceHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PriceHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PriceHolds(ctx, req.(*PriceHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ApprovePriceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ApprovePriceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ApprovePriceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ApprovePriceHold(ctx, req.(*PriceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RejectPriceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RejectPriceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RejectPriceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RejectPriceHold(ctx, req.(*PriceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ProductPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ProductPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ProductPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ProductPriceHistory(ctx, req.(*ProductPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product.ProductService",
+	HandlerType: (*ProductServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _ProductService_Search_Handler,
+		},
+		{
+			MethodName: "Show",
+			Handler:    _ProductService_Show_Handler,
+		},
+		{
+			MethodName: "Offers",
+			Handler:    _ProductService_Offers_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _ProductService_Suggest_Handler,
+		},
+		{
+			MethodName: "ScanBarcode",
+			Handler:    _ProductService_ScanBarcode_Handler,
+		},
+		{
+			MethodName: "Analogs",
+			Handler:    _ProductService_Analogs_Handler,
+		},
+		{
+			MethodName: "SupplierMatches",
+			Handler:    _ProductService_SupplierMatches_Handler,
+		},
+		{
+			MethodName: "AcceptSupplierMatch",
+			Handler:    _ProductService_AcceptSupplierMatch_Handler,
+		},
+		{
+			MethodName: "RejectSupplierMatch",
+			Handler:    _ProductService_RejectSupplierMatch_Handler,
+		},
+		{
+			MethodName: "SupplierImportRuns",
+			Handler:    _ProductService_SupplierImportRuns_Handler,
+		},
+		{
+			MethodName: "SupplierImportRun",
+			Handler:    _ProductService_SupplierImportRun_Handler,
+		},
+		{
+			MethodName: "RollbackSupplierImport",
+			Handler:    _ProductService_RollbackSupplierImport_Handler,
+		},
+		{
+			MethodName: "SupplierDryRun",
+			Handler:    _ProductService_SupplierDryRun_Handler,
+		},
+		{
+			MethodName: "PriceHolds",
+			Handler:    _ProductService_PriceHolds_Handler,
+		},
+		{
+			MethodName: "ApprovePriceHold",
+			Handler:    _ProductService_ApprovePriceHold_Handler,
+		},
+		{
+			MethodName: "RejectPriceHold",
+			Handler:    _ProductService_RejectPriceHold_Handler,
+		},
+		{
+			MethodName: "ProductPriceHistory",
+			Handler:    _ProductService_ProductPriceHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "product_service.proto",
+}